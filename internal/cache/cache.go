@@ -0,0 +1,50 @@
+package cache
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// Root returns the directory sqlc uses to cache WASM plugins and catalog
+// snapshots. It can be set with the SQLCCACHE environment variable and
+// otherwise follows the XDG Base Directory Specification.
+func Root() (string, error) {
+	cache := os.Getenv("SQLCCACHE")
+	if cache != "" {
+		return cache, nil
+	}
+	cacheHome := os.Getenv("XDG_CACHE_HOME")
+	if cacheHome == "" {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return "", err
+		}
+		cacheHome = filepath.Join(home, ".cache")
+	}
+	return filepath.Join(cacheHome, "sqlc"), nil
+}
+
+// PluginsDir returns the directory used to cache WASM plugins, creating it if
+// it doesn't exist.
+func PluginsDir() (string, error) {
+	return subdir("plugins")
+}
+
+// CatalogsDir returns the directory used to cache catalog snapshots, creating
+// it if it doesn't exist.
+func CatalogsDir() (string, error) {
+	return subdir("catalogs")
+}
+
+func subdir(name string) (string, error) {
+	root, err := Root()
+	if err != nil {
+		return "", err
+	}
+	dir := filepath.Join(root, name)
+	if err := os.MkdirAll(dir, 0755); err != nil && !os.IsExist(err) {
+		return "", fmt.Errorf("failed to create cache directory: %w", err)
+	}
+	return dir, nil
+}
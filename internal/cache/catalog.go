@@ -0,0 +1,211 @@
+package cache
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/gob"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ZeyuRemtes/sqlc/internal/info"
+	"github.com/ZeyuRemtes/sqlc/internal/sql/ast"
+	"github.com/ZeyuRemtes/sqlc/internal/sql/catalog"
+)
+
+// Bump this whenever the layout of catalog.Catalog changes in a way that
+// makes existing snapshots unreadable or wrong
+const snapshotFormat = 6
+
+const snapshotExt = ".catalog"
+
+func init() {
+	gob.Register(&catalog.Enum{})
+	gob.Register(&catalog.CompositeType{})
+	gob.Register(&ast.String{})
+	gob.Register(&ast.Integer{})
+	gob.Register(&ast.A_Const{})
+}
+
+// A Snapshot is a catalog built from a set of schema files.
+type Snapshot struct {
+	Engine  string
+	Version string
+	Schema  []string
+	Created time.Time
+	Catalog *catalog.Catalog
+}
+
+// CatalogKey returns the cache key for a catalog built by engine from the
+// given schema files. Both the names and the contents of the files are part
+// of the key, as is the sqlc binary building the catalog.
+func CatalogKey(engine string, files []string, contents [][]byte) string {
+	h := sha256.New()
+	fmt.Fprintf(h, "%d\x00%s\x00%s\x00", snapshotFormat, buildID(), engine)
+	for i := range files {
+		fmt.Fprintf(h, "%s\x00%d\x00", filepath.Base(files[i]), len(contents[i]))
+		h.Write(contents[i])
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+var (
+	buildIDOnce sync.Once
+	buildIDHash string
+)
+
+// buildID identifies the running sqlc binary by the hash of its executable, so
+// that development builds sharing a version don't share snapshots. The version
+// is used if the executable can't be read.
+func buildID() string {
+	buildIDOnce.Do(func() {
+		buildIDHash = info.Version
+		path, err := os.Executable()
+		if err != nil {
+			return
+		}
+		f, err := os.Open(path)
+		if err != nil {
+			return
+		}
+		defer f.Close()
+		h := sha256.New()
+		if _, err := io.Copy(h, f); err != nil {
+			return
+		}
+		buildIDHash = hex.EncodeToString(h.Sum(nil))
+	})
+	return buildIDHash
+}
+
+// LoadCatalog returns the snapshot stored under key. Missing and unreadable
+// snapshots are both reported as a miss.
+func LoadCatalog(key string) (*Snapshot, bool) {
+	dir, err := CatalogsDir()
+	if err != nil {
+		return nil, false
+	}
+	blob, err := os.ReadFile(filepath.Join(dir, key+snapshotExt))
+	if err != nil {
+		return nil, false
+	}
+	var snap Snapshot
+	if err := gob.NewDecoder(bytes.NewReader(blob)).Decode(&snap); err != nil {
+		return nil, false
+	}
+	if snap.Catalog == nil {
+		return nil, false
+	}
+	return &snap, true
+}
+
+// StoreCatalog writes the snapshot under key. The file is written atomically
+// so that concurrent runs never observe a partial snapshot.
+func StoreCatalog(key string, snap *Snapshot) error {
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(snap); err != nil {
+		return err
+	}
+	dir, err := CatalogsDir()
+	if err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(dir, key+".*.tmp")
+	if err != nil {
+		return err
+	}
+	if _, err := tmp.Write(buf.Bytes()); err != nil {
+		tmp.Close()
+		os.Remove(tmp.Name())
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		os.Remove(tmp.Name())
+		return err
+	}
+	return os.Rename(tmp.Name(), filepath.Join(dir, key+snapshotExt))
+}
+
+// An Entry describes a catalog snapshot on disk.
+type Entry struct {
+	Key     string
+	Path    string
+	Size    int64
+	Engine  string
+	Version string
+	Schema  []string
+	Created time.Time
+}
+
+// ListCatalogs returns every catalog snapshot in the cache, most recently
+// created first.
+func ListCatalogs() ([]Entry, error) {
+	dir, err := CatalogsDir()
+	if err != nil {
+		return nil, err
+	}
+	files, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	var entries []Entry
+	for _, f := range files {
+		if f.IsDir() || !strings.HasSuffix(f.Name(), snapshotExt) {
+			continue
+		}
+		key := strings.TrimSuffix(f.Name(), snapshotExt)
+		entry := Entry{
+			Key:  key,
+			Path: filepath.Join(dir, f.Name()),
+		}
+		if fi, err := f.Info(); err == nil {
+			entry.Size = fi.Size()
+		}
+		if snap, ok := LoadCatalog(key); ok {
+			entry.Engine = snap.Engine
+			entry.Version = snap.Version
+			entry.Schema = snap.Schema
+			entry.Created = snap.Created
+		}
+		entries = append(entries, entry)
+	}
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].Created.After(entries[j].Created)
+	})
+	return entries, nil
+}
+
+// ClearCatalogs removes every catalog snapshot from the cache and returns the
+// number of snapshots removed.
+func ClearCatalogs() (int, error) {
+	dir, err := CatalogsDir()
+	if err != nil {
+		return 0, err
+	}
+	files, err := os.ReadDir(dir)
+	if err != nil {
+		return 0, err
+	}
+	var removed int
+	for _, f := range files {
+		if f.IsDir() {
+			continue
+		}
+		if !strings.HasSuffix(f.Name(), snapshotExt) && !strings.HasSuffix(f.Name(), ".tmp") {
+			continue
+		}
+		if err := os.Remove(filepath.Join(dir, f.Name())); err != nil {
+			return removed, err
+		}
+		if strings.HasSuffix(f.Name(), snapshotExt) {
+			removed++
+		}
+	}
+	return removed, nil
+}
@@ -0,0 +1,96 @@
+package cache
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/ZeyuRemtes/sqlc/internal/info"
+	"github.com/ZeyuRemtes/sqlc/internal/sql/ast"
+	"github.com/ZeyuRemtes/sqlc/internal/sql/catalog"
+)
+
+func TestCatalogKey(t *testing.T) {
+	files := []string{"a/schema.sql"}
+	key := CatalogKey("sqlite", files, [][]byte{[]byte("CREATE TABLE foo (id int);")})
+	for _, test := range []struct {
+		name     string
+		engine   string
+		files    []string
+		contents string
+	}{
+		{"engine", "mysql", files, "CREATE TABLE foo (id int);"},
+		{"filename", "sqlite", []string{"a/other.sql"}, "CREATE TABLE foo (id int);"},
+		{"contents", "sqlite", files, "CREATE TABLE bar (id int);"},
+	} {
+		if CatalogKey(test.engine, test.files, [][]byte{[]byte(test.contents)}) == key {
+			t.Errorf("changing the %s didn't change the key", test.name)
+		}
+	}
+	moved := CatalogKey("sqlite", []string{"b/schema.sql"}, [][]byte{[]byte("CREATE TABLE foo (id int);")})
+	if moved != key {
+		t.Errorf("moving the schema to another directory changed the key")
+	}
+}
+
+func TestCatalogSnapshot(t *testing.T) {
+	t.Setenv("SQLCCACHE", t.TempDir())
+
+	if _, ok := LoadCatalog("missing"); ok {
+		t.Fatalf("expected a miss for an unknown key")
+	}
+
+	c := catalog.New("main")
+	c.Schemas[0].Tables = []*catalog.Table{
+		{
+			Rel: &ast.TableName{Name: "authors"},
+			Columns: []*catalog.Column{
+				{Name: "id", Type: ast.TypeName{Name: "integer"}, IsNotNull: true},
+				{Name: "bio", Type: ast.TypeName{Name: "text"}},
+			},
+		},
+	}
+	c.Schemas[0].Types = []catalog.Type{
+		&catalog.Enum{Name: "mood", Vals: []string{"sad", "happy"}},
+	}
+	if err := StoreCatalog("key", &Snapshot{Engine: "sqlite", Catalog: c}); err != nil {
+		t.Fatal(err)
+	}
+
+	snap, ok := LoadCatalog("key")
+	if !ok {
+		t.Fatalf("expected a hit after storing a snapshot")
+	}
+	if diff := cmp.Diff(c, snap.Catalog, cmpopts.IgnoreFields(catalog.Catalog{}, "LoadExtension")); diff != "" {
+		t.Errorf("catalog differs after a round trip:\n%s", diff)
+	}
+
+	entries, err := ListCatalogs()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 1 || entries[0].Key != "key" || entries[0].Engine != "sqlite" {
+		t.Errorf("unexpected entries: %+v", entries)
+	}
+
+	n, err := ClearCatalogs()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n != 1 {
+		t.Errorf("expected 1 snapshot to be removed; got %d", n)
+	}
+	if _, ok := LoadCatalog("key"); ok {
+		t.Errorf("expected a miss after clearing the cache")
+	}
+}
+
+func TestBuildID(t *testing.T) {
+	if buildID() == info.Version {
+		t.Errorf("expected the hash of the test binary, not the version")
+	}
+	if buildID() != buildID() {
+		t.Errorf("expected the same build ID on every call")
+	}
+}
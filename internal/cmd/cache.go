@@ -0,0 +1,63 @@
+package cmd
+
+import (
+	"fmt"
+	"runtime/trace"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ZeyuRemtes/sqlc/internal/cache"
+)
+
+func NewCmdCache() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cache",
+		Short: "Inspect and clear cached catalog snapshots",
+	}
+	cmd.AddCommand(&cobra.Command{
+		Use:   "inspect",
+		Short: "List cached catalog snapshots",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			defer trace.StartRegion(cmd.Context(), "cache inspect").End()
+			dir, err := cache.CatalogsDir()
+			if err != nil {
+				return err
+			}
+			entries, err := cache.ListCatalogs()
+			if err != nil {
+				return err
+			}
+			stdout := cmd.OutOrStdout()
+			fmt.Fprintf(stdout, "%s\n", dir)
+			if len(entries) == 0 {
+				return nil
+			}
+			tw := tabwriter.NewWriter(stdout, 0, 8, 2, ' ', 0)
+			fmt.Fprintf(tw, "KEY\tENGINE\tVERSION\tCREATED\tSIZE\tSCHEMA\n")
+			for _, e := range entries {
+				var created string
+				if !e.Created.IsZero() {
+					created = e.Created.Format("2006-01-02 15:04:05")
+				}
+				fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%d\t%s\n", e.Key[:12], e.Engine, e.Version, created, e.Size, strings.Join(e.Schema, ", "))
+			}
+			return tw.Flush()
+		},
+	})
+	cmd.AddCommand(&cobra.Command{
+		Use:   "clear",
+		Short: "Remove all cached catalog snapshots",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			defer trace.StartRegion(cmd.Context(), "cache clear").End()
+			n, err := cache.ClearCatalogs()
+			if err != nil {
+				return err
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "removed %d catalog snapshot(s)\n", n)
+			return nil
+		},
+	})
+	return cmd
+}
@@ -29,6 +29,8 @@ func init() {
 	initCmd.Flags().BoolP("v1", "", false, "generate v1 config yaml file")
 	initCmd.Flags().BoolP("v2", "", true, "generate v2 config yaml file")
 	initCmd.MarkFlagsMutuallyExclusive("v1", "v2")
+	genCmd.Flags().Bool("strict", false, "treat compiler warnings as errors (default: false)")
+	checkCmd.Flags().Bool("strict", false, "treat compiler warnings as errors (default: false)")
 }
 
 // Do runs the command logic.
@@ -46,6 +48,9 @@ func Do(args []string, stdin io.Reader, stdout io.Writer, stderr io.Writer) int
 	rootCmd.AddCommand(versionCmd)
 	rootCmd.AddCommand(uploadCmd)
 	rootCmd.AddCommand(NewCmdVet())
+	rootCmd.AddCommand(NewCmdCache())
+	rootCmd.AddCommand(NewCmdIntrospect())
+	rootCmd.AddCommand(NewCmdMigrate())
 
 	rootCmd.SetArgs(args)
 	rootCmd.SetIn(stdin)
@@ -138,17 +143,20 @@ type Env struct {
 	Debug      opts.Debug
 	NoRemote   bool
 	NoDatabase bool
+	Strict     bool
 }
 
 func ParseEnv(c *cobra.Command) Env {
 	dr := c.Flag("dry-run")
 	nr := c.Flag("no-remote")
 	nodb := c.Flag("no-database")
+	strict := c.Flag("strict")
 	return Env{
 		DryRun:     dr != nil && dr.Changed,
 		Debug:      opts.DebugFromEnv(),
 		NoRemote:   nr != nil && nr.Value.String() == "true",
 		NoDatabase: nodb != nil && nodb.Value.String() == "true",
+		Strict:     strict != nil && strict.Value.String() == "true",
 	}
 }
 
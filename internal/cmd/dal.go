@@ -0,0 +1,220 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"path/filepath"
+
+	"github.com/ZeyuRemtes/sqlc/internal/codegen/golang"
+	"github.com/ZeyuRemtes/sqlc/internal/compiler"
+	"github.com/ZeyuRemtes/sqlc/internal/multierr"
+	"github.com/ZeyuRemtes/sqlc/internal/plugin"
+)
+
+// dalMethods are the DAL methods generated by a sql block with Go output
+type dalMethods struct {
+	engine  string
+	pkg     string
+	out     string // the output directory
+	req     *plugin.CodeGenRequest
+	sigs    []golang.Signature
+	queries map[string]*compiler.Query
+}
+
+func newDALMethods(engine, pkg, out string, result *compiler.Result, req *plugin.CodeGenRequest, sigs []golang.Signature) *dalMethods {
+	d := &dalMethods{
+		engine:  engine,
+		pkg:     pkg,
+		out:     out,
+		req:     req,
+		sigs:    sigs,
+		queries: map[string]*compiler.Query{},
+	}
+	for _, q := range result.Queries {
+		d.queries[q.Name] = q
+	}
+	return d
+}
+
+// checkSharedDAL compares the DAL methods of the sql blocks generating the
+// same Go package. Each engine has its own Access type implementing the DAL
+// interface, which only builds if they all generate the same methods. The
+// methods of each block are compared to the first block of the package.
+func checkSharedDAL(stderr io.Writer, dir string, blocks []*dalMethods) bool {
+	failed := false
+	for _, group := range packages(blocks) {
+		var errs []*multierr.FileError
+		for _, other := range group[1:] {
+			errs = append(errs, compareDAL(dir, group[0], other)...)
+		}
+		if len(errs) == 0 {
+			continue
+		}
+		failed = true
+		fmt.Fprintf(stderr, "# package %s\n", group[0].pkg)
+		for _, err := range errs {
+			printFileErr(stderr, dir, err)
+		}
+	}
+	return failed
+}
+
+// generateShared generates the Go packages of more than one sql block into
+// output, merging the models and DAL of their engines
+func generateShared(ctx context.Context, stderr io.Writer, blocks []*dalMethods, output map[string]string) bool {
+	failed := false
+	for _, group := range packages(blocks) {
+		if len(group) < 2 {
+			continue
+		}
+		reqs := make([]*plugin.CodeGenRequest, 0, len(group))
+		for _, b := range group {
+			reqs = append(reqs, b.req)
+		}
+		resp, err := golang.GenerateMerged(ctx, reqs)
+		if err != nil {
+			failed = true
+			fmt.Fprintf(stderr, "# package %s\n", group[0].pkg)
+			errs := []error{err}
+			var joined interface{ Unwrap() []error }
+			if errors.As(err, &joined) {
+				errs = joined.Unwrap()
+			}
+			for _, err := range errs {
+				fmt.Fprintf(stderr, "error generating code: %s\n", err)
+			}
+			continue
+		}
+		for _, file := range resp.Files {
+			output[filepath.Join(group[0].out, file.Name)] = string(file.Contents)
+		}
+	}
+	return failed
+}
+
+// packages groups the sql blocks by the Go package they generate, in the
+// order of the configuration
+func packages(blocks []*dalMethods) [][]*dalMethods {
+	var order []string
+	byOut := map[string][]*dalMethods{}
+	for _, b := range blocks {
+		if b == nil {
+			continue
+		}
+		if _, ok := byOut[b.out]; !ok {
+			order = append(order, b.out)
+		}
+		byOut[b.out] = append(byOut[b.out], b)
+	}
+	groups := make([][]*dalMethods, 0, len(order))
+	for _, out := range order {
+		groups = append(groups, byOut[out])
+	}
+	return groups
+}
+
+func compareDAL(dir string, first, other *dalMethods) []*multierr.FileError {
+	var errs []*multierr.FileError
+	theirs := map[string]golang.Signature{}
+	for _, sig := range other.sigs {
+		theirs[sig.Name] = sig
+	}
+
+	for _, sig := range first.sigs {
+		o, ok := theirs[sig.Name]
+		if !ok {
+			errs = append(errs, queryErr(first.queries[sig.Name], "query %q is defined for %s, but not for %s", sig.Name, first.engine, other.engine))
+			continue
+		}
+		if err := compareSignature(dir, first, other, sig, o); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	mine := map[string]bool{}
+	for _, sig := range first.sigs {
+		mine[sig.Name] = true
+	}
+	for _, sig := range other.sigs {
+		if !mine[sig.Name] {
+			errs = append(errs, queryErr(other.queries[sig.Name], "query %q is defined for %s, but not for %s", sig.Name, other.engine, first.engine))
+		}
+	}
+	return errs
+}
+
+// checkSharedQueries compares the methods generated for a query file used by
+// sql blocks of different packages, which may hold engine variants of its
+// queries. Blocks of the same package are compared by checkSharedDAL.
+func checkSharedQueries(stderr io.Writer, dir string, blocks []*dalMethods) bool {
+	type use struct {
+		block *dalMethods
+		sig   golang.Signature
+	}
+	firsts := map[string]use{}
+	var order []string
+	errs := map[string][]*multierr.FileError{}
+	for _, b := range blocks {
+		if b == nil {
+			continue
+		}
+		for _, sig := range b.sigs {
+			key := b.queries[sig.Name].Path + ":" + sig.Name
+			first, ok := firsts[key]
+			if !ok {
+				firsts[key] = use{b, sig}
+				continue
+			}
+			if first.block.out == b.out {
+				continue
+			}
+			if err := compareSignature(dir, first.block, b, first.sig, sig); err != nil {
+				if _, ok := errs[b.pkg]; !ok {
+					order = append(order, b.pkg)
+				}
+				errs[b.pkg] = append(errs[b.pkg], err)
+			}
+		}
+	}
+	for _, pkg := range order {
+		fmt.Fprintf(stderr, "# package %s\n", pkg)
+		for _, err := range errs[pkg] {
+			printFileErr(stderr, dir, err)
+		}
+	}
+	return len(order) > 0
+}
+
+// compareSignature compares the methods generated for a query by two sql
+// blocks, reporting the first difference at the query of the first block
+func compareSignature(dir string, first, other *dalMethods, sig, o golang.Signature) *multierr.FileError {
+	q := first.queries[sig.Name]
+	where := location(dir, other.queries[sig.Name])
+	switch {
+	case sig.Cmd != o.Cmd:
+		return queryErr(q, "query %q is %s for %s, but %s for %s at %s", sig.Name, sig.Cmd, first.engine, o.Cmd, other.engine, where)
+	case sig.Params != o.Params:
+		return queryErr(q, "query %q takes (%s) for %s, but (%s) for %s at %s", sig.Name, sig.Params, first.engine, o.Params, other.engine, where)
+	case sig.Results != o.Results:
+		return queryErr(q, "query %q returns (%s) for %s, but (%s) for %s at %s", sig.Name, sig.Results, first.engine, o.Results, other.engine, where)
+	}
+	return nil
+}
+
+func queryErr(q *compiler.Query, format string, args ...interface{}) *multierr.FileError {
+	return &multierr.FileError{
+		Filename: q.Path,
+		Line:     q.Line,
+		Column:   q.Column,
+		Err:      fmt.Errorf(format, args...),
+	}
+}
+
+func location(dir string, q *compiler.Query) string {
+	filename, err := filepath.Rel(dir, q.Path)
+	if err != nil {
+		filename = q.Path
+	}
+	return fmt.Sprintf("%s:%d:%d", filename, q.Line, q.Column)
+}
@@ -55,6 +55,26 @@ func printFileErr(stderr io.Writer, dir string, fileErr *multierr.FileError) {
 	fmt.Fprintf(stderr, "%s:%d:%d: %s\n", filename, fileErr.Line, fileErr.Column, fileErr.Err)
 }
 
+func printFileWarn(stderr io.Writer, dir string, fileErr *multierr.FileError) {
+	filename, err := filepath.Rel(dir, fileErr.Filename)
+	if err != nil {
+		filename = fileErr.Filename
+	}
+	fmt.Fprintf(stderr, "%s:%d:%d: warning: %s\n", filename, fileErr.Line, fileErr.Column, fileErr.Err)
+}
+
+// printWarnings reports compiler warnings. In strict mode the warnings are
+// reported as errors.
+func printWarnings(stderr io.Writer, dir string, warns []*multierr.FileError, strict bool) {
+	for _, warn := range warns {
+		if strict {
+			printFileErr(stderr, dir, warn)
+		} else {
+			printFileWarn(stderr, dir, warn)
+		}
+	}
+}
+
 type outPair struct {
 	Gen    config.SQLGen
 	Plugin *config.Codegen
@@ -178,11 +198,22 @@ func Generate(ctx context.Context, e Env, dir, filename string, stderr io.Writer
 	grp, gctx := errgroup.WithContext(ctx)
 	grp.SetLimit(runtime.GOMAXPROCS(0))
 
+	// Go packages generated by more than one sql block are merged once every
+	// block is compiled
+	goOuts := map[string]int{}
+	for _, pair := range pairs {
+		if pair.Gen.Go != nil {
+			goOuts[filepath.Join(dir, pair.Gen.Go.Out)]++
+		}
+	}
+
 	stderrs := make([]bytes.Buffer, len(pairs))
+	dals := make([]*dalMethods, len(pairs))
 
 	for i, pair := range pairs {
 		sql := pair
 		errout := &stderrs[i]
+		idx := i
 
 		grp.Go(func() error {
 			combo := config.Combine(*conf, sql.SQL)
@@ -205,7 +236,8 @@ func Generate(ctx context.Context, e Env, dir, filename string, stderr io.Writer
 
 			var name, lang string
 			parseOpts := opts.Parser{
-				Debug: debug.Debug,
+				Debug:      debug.Debug,
+				NoDatabase: e.NoDatabase,
 			}
 
 			switch {
@@ -227,16 +259,44 @@ func Generate(ctx context.Context, e Env, dir, filename string, stderr io.Writer
 				errored = true
 				return nil
 			}
+			if len(result.Warnings) > 0 {
+				fmt.Fprintf(errout, "# package %s\n", name)
+				printWarnings(errout, dir, result.Warnings, e.Strict)
+				if e.Strict {
+					packageRegion.End()
+					errored = true
+					return nil
+				}
+			}
 
 			out, resp, err := codegen(gctx, combo, sql, result)
 			if err != nil {
-				fmt.Fprintf(errout, "# package %s\n", name)
+				if len(result.Warnings) == 0 {
+					fmt.Fprintf(errout, "# package %s\n", name)
+				}
 				fmt.Fprintf(errout, "error generating code: %s\n", err)
 				errored = true
 				packageRegion.End()
 				return nil
 			}
 
+			if sql.Gen.Go != nil {
+				req := codeGenRequest(result, combo)
+				sigs, err := golang.Signatures(req)
+				if err != nil {
+					fmt.Fprintf(errout, "# package %s\n", name)
+					fmt.Fprintf(errout, "error generating code: %s\n", err)
+					errored = true
+					packageRegion.End()
+					return nil
+				}
+				dals[idx] = newDALMethods(string(sql.Engine), name, filepath.Join(dir, out), result, req, sigs)
+				if goOuts[filepath.Join(dir, out)] > 1 {
+					packageRegion.End()
+					return nil
+				}
+			}
+
 			files := map[string]string{}
 			for _, file := range resp.Files {
 				files[file.Name] = string(file.Contents)
@@ -256,12 +316,21 @@ func Generate(ctx context.Context, e Env, dir, filename string, stderr io.Writer
 	if err := grp.Wait(); err != nil {
 		return nil, err
 	}
-	if errored {
-		for i, _ := range stderrs {
-			if _, err := io.Copy(stderr, &stderrs[i]); err != nil {
-				return nil, err
-			}
+	for i, _ := range stderrs {
+		if _, err := io.Copy(stderr, &stderrs[i]); err != nil {
+			return nil, err
 		}
+	}
+	if !errored && checkSharedDAL(stderr, dir, dals) {
+		errored = true
+	}
+	if !errored && checkSharedQueries(stderr, dir, dals) {
+		errored = true
+	}
+	if !errored && generateShared(ctx, stderr, dals, output) {
+		errored = true
+	}
+	if errored {
 		return nil, fmt.Errorf("errored")
 	}
 	return output, nil
@@ -333,7 +402,18 @@ func remoteGenerate(ctx context.Context, configPath string, conf *config.Config,
 func parse(ctx context.Context, name, dir string, sql config.SQL, combo config.CombinedSettings, parserOpts opts.Parser, stderr io.Writer) (*compiler.Result, bool) {
 	defer trace.StartRegion(ctx, "parse").End()
 	c := compiler.NewCompiler(sql, combo)
-	if err := c.ParseCatalog(sql.Schema); err != nil {
+	if sql.Database != nil && sql.Database.Introspect {
+		err := fmt.Errorf("database: connections disabled via command line flag")
+		if !parserOpts.NoDatabase {
+			err = c.IntrospectCatalog(ctx, databaseURL(dir, sql.Engine, sql.Database.URL))
+		}
+		if err != nil {
+			fmt.Fprintf(stderr, "# package %s\n", name)
+			fmt.Fprintf(stderr, "error introspecting database: %s\n", err)
+			return nil, true
+		}
+	}
+	if err := c.ParseCatalog(sql.Schema, parserOpts); err != nil {
 		fmt.Fprintf(stderr, "# package %s\n", name)
 		if parserErr, ok := err.(*multierr.Error); ok {
 			for _, fileErr := range parserErr.Errs() {
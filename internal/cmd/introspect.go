@@ -0,0 +1,85 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"runtime/trace"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ZeyuRemtes/sqlc/internal/config"
+	"github.com/ZeyuRemtes/sqlc/internal/introspect"
+	"github.com/ZeyuRemtes/sqlc/internal/shfmt"
+)
+
+func NewCmdIntrospect() *cobra.Command {
+	return &cobra.Command{
+		Use:   "introspect",
+		Short: "Print the schema of each configured database as DDL",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			defer trace.StartRegion(cmd.Context(), "introspect").End()
+			stderr := cmd.ErrOrStderr()
+			dir, name := getConfigPath(stderr, cmd.Flag("file"))
+			if err := Introspect(cmd.Context(), ParseEnv(cmd), dir, name, cmd.OutOrStdout(), stderr); err != nil {
+				fmt.Fprintf(stderr, "%s\n", err)
+				os.Exit(1)
+			}
+			return nil
+		},
+	}
+}
+
+func Introspect(ctx context.Context, e Env, dir, filename string, stdout, stderr io.Writer) error {
+	configPath, conf, err := readConfig(stderr, dir, filename)
+	if err != nil {
+		return err
+	}
+	if err := config.Validate(conf); err != nil {
+		return fmt.Errorf("error validating %s: %s", filepath.Base(configPath), err)
+	}
+	if e.NoDatabase {
+		return fmt.Errorf("database: connections disabled via command line flag")
+	}
+	found := false
+	for _, sql := range conf.SQL {
+		if sql.Database == nil {
+			continue
+		}
+		tables, err := introspect.Load(ctx, sql.Engine, databaseURL(dir, sql.Engine, sql.Database.URL))
+		if err != nil {
+			return err
+		}
+		if found {
+			fmt.Fprintln(stdout)
+		}
+		found = true
+		fmt.Fprint(stdout, introspect.DDL(sql.Engine, tables))
+	}
+	if !found {
+		return fmt.Errorf("no database configured in %s", filepath.Base(configPath))
+	}
+	return nil
+}
+
+// databaseURL expands environment variables in a database URL. SQLite paths
+// are relative to the directory holding the configuration file.
+func databaseURL(dir string, engine config.Engine, url string) string {
+	env := map[string]string{}
+	for _, e := range os.Environ() {
+		k, v, _ := strings.Cut(e, "=")
+		env[k] = v
+	}
+	url = shfmt.Replace(url, env)
+	if engine != config.EngineSQLite {
+		return url
+	}
+	path := strings.TrimPrefix(url, "file:")
+	if path == "" || filepath.IsAbs(path) || strings.HasPrefix(path, ":memory:") {
+		return url
+	}
+	return "file:" + filepath.Join(dir, path)
+}
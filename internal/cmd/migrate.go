@@ -0,0 +1,244 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"runtime/trace"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ZeyuRemtes/sqlc/internal/compiler"
+	"github.com/ZeyuRemtes/sqlc/internal/config"
+	"github.com/ZeyuRemtes/sqlc/internal/debug"
+	"github.com/ZeyuRemtes/sqlc/internal/migrations"
+	"github.com/ZeyuRemtes/sqlc/internal/multierr"
+	"github.com/ZeyuRemtes/sqlc/internal/opts"
+	"github.com/ZeyuRemtes/sqlc/internal/schemadiff"
+	"github.com/ZeyuRemtes/sqlc/internal/sql/catalog"
+	"github.com/ZeyuRemtes/sqlc/internal/sql/sqlpath"
+)
+
+func NewCmdMigrate() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "migrate",
+		Short: "Create schema migrations",
+	}
+	diff := &cobra.Command{
+		Use:   "diff",
+		Short: "Create a migration from the configured schema to the desired schema",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			defer trace.StartRegion(cmd.Context(), "migrate diff").End()
+			stderr := cmd.ErrOrStderr()
+			dir, name := getConfigPath(stderr, cmd.Flag("file"))
+			var o MigrateDiffOptions
+			o.From, _ = cmd.Flags().GetStringSlice("from")
+			o.To, _ = cmd.Flags().GetStringSlice("to")
+			o.Schema, _ = cmd.Flags().GetString("schema")
+			o.Name, _ = cmd.Flags().GetString("name")
+			o.DryRun, _ = cmd.Flags().GetBool("dry-run")
+			if err := MigrateDiff(dir, name, o, cmd.OutOrStdout(), stderr); err != nil {
+				fmt.Fprintf(stderr, "%s\n", err)
+				os.Exit(1)
+			}
+			return nil
+		},
+	}
+	diff.Flags().StringSlice("to", nil, "desired schema files or directories")
+	diff.Flags().StringSlice("from", nil, "current schema files or directories (default: the configured schema)")
+	diff.Flags().String("schema", "", "configured schema to migrate, when there is more than one")
+	diff.Flags().String("name", "schema_diff", "name of the migration")
+	diff.Flags().Bool("dry-run", false, "print the migration instead of writing it (default: false)")
+	diff.MarkFlagRequired("to")
+	cmd.AddCommand(diff)
+	return cmd
+}
+
+type MigrateDiffOptions struct {
+	From   []string
+	To     []string
+	Schema string
+	Name   string
+	DryRun bool
+}
+
+// MigrateDiff compares the configured schema with the desired one and writes
+// the statements that migrate the first to the second as a new migration.
+func MigrateDiff(dir, filename string, o MigrateDiffOptions, stdout, stderr io.Writer) error {
+	configPath, conf, err := readConfig(stderr, dir, filename)
+	if err != nil {
+		return err
+	}
+	if err := config.Validate(conf); err != nil {
+		return fmt.Errorf("error validating %s: %s", filepath.Base(configPath), err)
+	}
+	sql, err := migrateTarget(conf, o.Schema)
+	if err != nil {
+		return err
+	}
+	combo := config.Combine(*conf, sql)
+
+	from := sql.Schema
+	if len(o.From) > 0 {
+		from = o.From
+	}
+	fromCatalog, err := migrateCatalog(dir, sql, combo, from, stderr)
+	if err != nil {
+		return err
+	}
+	// The desired schema is read as is, whatever tool manages the migrations
+	to := sql
+	to.Migrations = ""
+	toCatalog, err := migrateCatalog(dir, to, combo, o.To, stderr)
+	if err != nil {
+		return err
+	}
+
+	up, err := schemadiff.Diff(sql.Engine, fromCatalog, toCatalog)
+	if err != nil {
+		return err
+	}
+	if len(up) == 0 {
+		fmt.Fprintln(stderr, "no schema changes")
+		return nil
+	}
+	down, err := schemadiff.Diff(sql.Engine, toCatalog, fromCatalog)
+	if err != nil {
+		return err
+	}
+	if o.DryRun {
+		fmt.Fprint(stdout, migrationSQL(sql.Migrations, up, down, time.Now()))
+		return nil
+	}
+
+	out, err := migrationsDir(dir, sql.Schema)
+	if err != nil {
+		return err
+	}
+	files, err := sqlpath.Glob([]string{out})
+	if err != nil {
+		return err
+	}
+	now := time.Now()
+	upName, downName, err := migrations.FileNames(sql.Migrations, files, o.Name, now)
+	if err != nil {
+		return err
+	}
+	written := []string{filepath.Join(out, upName)}
+	if err := os.WriteFile(written[0], []byte(migrationSQL(sql.Migrations, up, down, now)), 0644); err != nil {
+		return err
+	}
+	if downName != "" {
+		written = append(written, filepath.Join(out, downName))
+		if err := os.WriteFile(written[1], []byte(migrationSQL("", down, nil, now)), 0644); err != nil {
+			return err
+		}
+	}
+	for _, path := range written {
+		if rel, err := filepath.Rel(dir, path); err == nil {
+			path = rel
+		}
+		fmt.Fprintf(stdout, "%s\n", path)
+	}
+	if sql.Migrations == migrations.Atlas {
+		// Until then, atlas and sqlc both refuse the unlisted file
+		fmt.Fprintln(stderr, "run atlas migrate hash to add the migration to atlas.sum")
+	}
+	return nil
+}
+
+// migrateTarget returns the configured SQL package whose schema is migrated
+func migrateTarget(conf *config.Config, schema string) (config.SQL, error) {
+	var found []config.SQL
+	seen := map[string]bool{}
+	for _, sql := range conf.SQL {
+		if schema != "" && !contains(sql.Schema, schema) {
+			continue
+		}
+		key := string(sql.Engine) + "\x00" + strings.Join(sql.Schema, "\x00")
+		if !seen[key] {
+			seen[key] = true
+			found = append(found, sql)
+		}
+	}
+	switch {
+	case len(found) == 0 && schema != "":
+		return config.SQL{}, fmt.Errorf("no configured schema matches %s", schema)
+	case len(found) == 0:
+		return config.SQL{}, fmt.Errorf("no schema configured")
+	case len(found) > 1:
+		return config.SQL{}, fmt.Errorf("more than one schema configured; choose one with --schema")
+	}
+	return found[0], nil
+}
+
+func contains(paths []string, path string) bool {
+	for _, p := range paths {
+		if filepath.Clean(p) == filepath.Clean(path) {
+			return true
+		}
+	}
+	return false
+}
+
+func migrateCatalog(dir string, sql config.SQL, combo config.CombinedSettings, paths []string, stderr io.Writer) (*catalog.Catalog, error) {
+	joined := make([]string, 0, len(paths))
+	for _, p := range paths {
+		if !filepath.IsAbs(p) {
+			p = filepath.Join(dir, p)
+		}
+		joined = append(joined, p)
+	}
+	c := compiler.NewCompiler(sql, combo)
+	if err := c.ParseCatalog(joined, opts.Parser{Debug: debug.Debug}); err != nil {
+		if parserErr, ok := err.(*multierr.Error); ok {
+			for _, fileErr := range parserErr.Errs() {
+				printFileErr(stderr, dir, fileErr)
+			}
+			return nil, fmt.Errorf("error parsing schema")
+		}
+		return nil, fmt.Errorf("error parsing schema: %s", err)
+	}
+	return c.Catalog(), nil
+}
+
+// migrationsDir returns the directory new migrations are written to, which is
+// the first configured schema path
+func migrationsDir(dir string, schema []string) (string, error) {
+	if len(schema) == 0 {
+		return "", fmt.Errorf("no schema configured")
+	}
+	path := filepath.Join(dir, schema[0])
+	info, err := os.Stat(path)
+	if err != nil {
+		return "", err
+	}
+	if !info.IsDir() {
+		return "", fmt.Errorf("schema %s is not a directory of migrations; use --dry-run to print the migration", schema[0])
+	}
+	return path, nil
+}
+
+// migrationSQL returns the contents of a migration file. Liquibase changesets
+// carry their own rollback statements.
+func migrationSQL(tool string, up, down []string, now time.Time) string {
+	var b strings.Builder
+	if tool == migrations.Liquibase {
+		fmt.Fprintf(&b, "-- liquibase formatted sql\n\n-- changeset sqlc:%s\n", now.UTC().Format("20060102150405"))
+	}
+	for _, stmt := range up {
+		fmt.Fprintf(&b, "%s\n", stmt)
+	}
+	if tool == migrations.Liquibase {
+		for _, stmt := range down {
+			if strings.HasPrefix(stmt, "--") {
+				continue
+			}
+			fmt.Fprintf(&b, "-- rollback %s\n", strings.Join(strings.Fields(stmt), " "))
+		}
+	}
+	return b.String()
+}
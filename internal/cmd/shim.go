@@ -96,6 +96,9 @@ func pluginGoCode(s config.SQLGo) *plugin.GoCode {
 		EmitPointersForNullTypes:    s.EmitPointersForNullTypes,
 		EmitEnumValidMethod:         s.EmitEnumValidMethod,
 		EmitAllEnumValues:           s.EmitAllEnumValues,
+		EmitTxHelpers:               s.EmitTxHelpers,
+		EmitReadReplica:             s.EmitReadReplica,
+		EmitHooks:                   s.EmitHooks,
 		JsonTagsCaseStyle:           s.JSONTagsCaseStyle,
 		Package:                     s.Package,
 		Out:                         s.Out,
@@ -156,6 +159,10 @@ func pluginCatalog(c *catalog.Catalog) *plugin.Catalog {
 		}
 		var tables []*plugin.Table
 		for _, t := range s.Tables {
+			// Tables built into the engine, such as sqlite_master, get no models
+			if t.IsSystem {
+				continue
+			}
 			var columns []*plugin.Column
 			for _, c := range t.Columns {
 				l := -1
@@ -169,11 +176,12 @@ func pluginCatalog(c *catalog.Catalog) *plugin.Catalog {
 						Schema:  c.Type.Schema,
 						Name:    c.Type.Name,
 					},
-					Comment:  c.Comment,
-					NotNull:  c.IsNotNull,
-					Unsigned: c.IsUnsigned,
-					IsArray:  c.IsArray,
-					Length:   int32(l),
+					Comment:      c.Comment,
+					NotNull:      c.IsNotNull,
+					Unsigned:     c.IsUnsigned,
+					IsArray:      c.IsArray,
+					IsPrimaryKey: c.IsPrimaryKey,
+					Length:       int32(l),
 					Table: &plugin.Identifier{
 						Catalog: t.Rel.Catalog,
 						Schema:  t.Rel.Schema,
@@ -229,6 +237,7 @@ func pluginQueries(r *compiler.Result) []*plugin.Query {
 		out = append(out, &plugin.Query{
 			Name:            q.Name,
 			Cmd:             q.Cmd,
+			Options:         q.Options,
 			Text:            q.SQL,
 			Comments:        q.Comments,
 			Columns:         columns,
@@ -246,16 +255,17 @@ func pluginQueryColumn(c *compiler.Column) *plugin.Column {
 		l = *c.Length
 	}
 	out := &plugin.Column{
-		Name:         c.Name,
-		OriginalName: c.OriginalName,
-		Comment:      c.Comment,
-		NotNull:      c.NotNull,
-		Unsigned:     c.Unsigned,
-		IsArray:      c.IsArray,
-		Length:       int32(l),
-		IsNamedParam: c.IsNamedParam,
-		IsFuncCall:   c.IsFuncCall,
-		IsSqlcSlice:  c.IsSqlcSlice,
+		Name:           c.Name,
+		OriginalName:   c.OriginalName,
+		Comment:        c.Comment,
+		NotNull:        c.NotNull,
+		Unsigned:       c.Unsigned,
+		IsArray:        c.IsArray,
+		Length:         int32(l),
+		IsNamedParam:   c.IsNamedParam,
+		IsFuncCall:     c.IsFuncCall,
+		IsSqlcSlice:    c.IsSqlcSlice,
+		IsSqlcOptional: c.IsSqlcOptional,
 	}
 
 	if c.Type != nil {
@@ -284,6 +294,7 @@ func pluginQueryColumn(c *compiler.Column) *plugin.Column {
 			Schema:  c.EmbedTable.Schema,
 			Name:    c.EmbedTable.Name,
 		}
+		out.EmbedMany = c.EmbedMany
 	}
 
 	return out
@@ -241,7 +241,8 @@ func (c *checker) checkSQL(ctx context.Context, s config.SQL) error {
 
 	var name string
 	parseOpts := opts.Parser{
-		Debug: debug.Debug,
+		Debug:      debug.Debug,
+		NoDatabase: c.NoDatabase,
 	}
 
 	result, failed := parse(ctx, name, c.Dir, s, combo, parseOpts, c.Stderr)
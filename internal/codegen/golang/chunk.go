@@ -0,0 +1,63 @@
+package golang
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Chunk splits the sqlc.slice argument of a query with the chunk option.
+// Generated methods call themselves once for each part of the slice and merge
+// the results.
+type Chunk struct {
+	Size  int
+	Slice string // the slice argument, such as ids or arg.IDs
+	Copy  string // the arguments copied for a chunk when they are a struct
+	Field string // the struct field set to a chunk
+	Args  string // the arguments of the call for a chunk
+}
+
+func chunk(q Query, size string, emitDBArg bool) (*Chunk, error) {
+	n, err := strconv.Atoi(size)
+	if err != nil || n < 1 {
+		return nil, fmt.Errorf("invalid chunk size %q", size)
+	}
+	c := &Chunk{Size: n}
+	var args []string
+	if emitDBArg {
+		args = append(args, "db")
+	}
+	switch {
+	case q.Arg.Struct == nil:
+		c.Slice = q.Arg.Name
+		args = append(args, q.Arg.Name+"[start:end]")
+	case !q.Arg.EmitStruct():
+		for _, f := range q.Arg.Struct.Fields {
+			name := q.Arg.VariableForField(f)
+			if f.HasSqlcSlice() {
+				c.Slice = name
+				name += "[start:end]"
+			}
+			args = append(args, name)
+		}
+	default:
+		for _, f := range q.Arg.Struct.Fields {
+			if f.HasSqlcSlice() {
+				c.Slice = q.Arg.Name + "." + f.Name
+				c.Field = f.Name
+			}
+		}
+		c.Copy = q.Arg.Name
+		arg := "chunk"
+		if q.Arg.IsPointer() {
+			c.Copy = "*" + q.Arg.Name
+			arg = "&chunk"
+		}
+		args = append(args, arg)
+	}
+	if c.Slice == "" {
+		return nil, fmt.Errorf("chunk requires a sqlc.slice argument")
+	}
+	c.Args = strings.Join(args, ", ")
+	return c, nil
+}
@@ -0,0 +1,161 @@
+package golang
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/ZeyuRemtes/sqlc/internal/metadata"
+	"github.com/ZeyuRemtes/sqlc/internal/plugin"
+)
+
+// CopyFrom describes how database/sql drivers run a :copyfrom query, either
+// as INSERT statements with as many rows as the placeholder limit allows, or
+// as a LOAD DATA LOCAL INFILE statement for MySQL.
+type CopyFrom struct {
+	Prefix   string // the statement up to its VALUES row
+	Row      string // the VALUES row, repeated for each inserted row
+	Suffix   string // the statement after its VALUES row
+	Columns  int    // the parameters of a row
+	Tx       bool   // whether all the statements run in one transaction
+	LoadData string // the INTO TABLE clause of LOAD DATA, for method=load_data
+}
+
+// copyFrom splits the single row INSERT of a :copyfrom query around its
+// VALUES row
+func copyFrom(req *plugin.CodeGenRequest, query *plugin.Query, q Query) (*CopyFrom, error) {
+	sql := query.Text
+	start := valuesRow(sql)
+	if start < 0 {
+		return nil, fmt.Errorf("can't find the VALUES row")
+	}
+	end, _ := closeParen(sql, start, req.Settings.Engine == "mysql")
+	if end < 0 {
+		return nil, fmt.Errorf("can't find the end of the VALUES row")
+	}
+	row, columns, err := positionalRow(sql[start : end+1])
+	if err != nil {
+		return nil, err
+	}
+	c := &CopyFrom{
+		Prefix:  sql[:start],
+		Row:     row,
+		Suffix:  sql[end+1:],
+		Columns: columns,
+	}
+	if strings.ContainsAny(c.Suffix, "?$") {
+		return nil, fmt.Errorf(":copyfrom only supports parameters in the VALUES row")
+	}
+	if tx, _ := metadata.Option(query.Options, "tx"); tx == "true" {
+		c.Tx = true
+	}
+	if method, _ := metadata.Option(query.Options, "method"); method == "load_data" {
+		if req.Settings.Engine != "mysql" {
+			return nil, fmt.Errorf("method=load_data is only supported by MySQL")
+		}
+		if c.Tx {
+			return nil, fmt.Errorf("method=load_data loads all rows with one statement and can't be used with tx=true")
+		}
+		if strings.TrimSpace(c.Suffix) != "" {
+			return nil, fmt.Errorf("method=load_data can't be used with clauses after VALUES")
+		}
+		c.LoadData = loadDataStatement(query, q)
+	}
+	return c, nil
+}
+
+// loadDataStatement returns the INTO TABLE clause of the LOAD DATA statement
+// of a :copyfrom query. The file name before it is registered at run time.
+func loadDataStatement(query *plugin.Query, q Query) string {
+	table := "`" + query.InsertIntoTable.Name + "`"
+	if query.InsertIntoTable.Schema != "" {
+		table = "`" + query.InsertIntoTable.Schema + "`." + table
+	}
+	var columns []string
+	if q.Arg.Struct == nil {
+		columns = append(columns, "`"+q.Arg.DBName+"`")
+	} else {
+		for _, f := range q.Arg.Struct.Fields {
+			columns = append(columns, "`"+f.DBName+"`")
+		}
+	}
+	return fmt.Sprintf("INTO TABLE %s CHARACTER SET utf8mb4 (%s)", table, strings.Join(columns, ", "))
+}
+
+// valuesRow returns the position of the opening parenthesis of the row after
+// the VALUES keyword, which MySQL also spells VALUE
+func valuesRow(sql string) int {
+	upper := strings.ToUpper(sql)
+	for offset := 0; ; {
+		i := strings.Index(upper[offset:], "VALUE")
+		if i < 0 {
+			return -1
+		}
+		i += offset
+		offset = i + len("VALUE")
+		if i > 0 && isIdentRune(rune(sql[i-1])) {
+			continue
+		}
+		j := offset
+		if j < len(sql) && (sql[j] == 'S' || sql[j] == 's') {
+			j++
+		}
+		for j < len(sql) && unicode.IsSpace(rune(sql[j])) {
+			j++
+		}
+		if j < len(sql) && sql[j] == '(' {
+			return j
+		}
+	}
+}
+
+func isIdentRune(r rune) bool {
+	return r == '_' || unicode.IsLetter(r) || unicode.IsDigit(r)
+}
+
+// positionalRow replaces the numbered placeholders of a VALUES row, which
+// SQLite uses for named parameters, with `?`, so that the row can be repeated.
+// The parameters have to be in order for the arguments to line up.
+func positionalRow(row string) (string, int, error) {
+	var b strings.Builder
+	var n int
+	for i := 0; i < len(row); i++ {
+		c := row[i]
+		switch c {
+		case '\'', '"', '`':
+			end := strings.IndexByte(row[i+1:], c)
+			if end < 0 {
+				return "", 0, fmt.Errorf("unterminated quote in the VALUES row")
+			}
+			b.WriteString(row[i : i+end+2])
+			i += end + 1
+			continue
+		case '?':
+			n++
+			j := i + 1
+			for j < len(row) && row[j] >= '0' && row[j] <= '9' {
+				j++
+			}
+			if j > i+1 {
+				if num, _ := strconv.Atoi(row[i+1 : j]); num != n {
+					return "", 0, fmt.Errorf(":copyfrom parameters must be in the same order as the columns")
+				}
+			}
+			b.WriteByte('?')
+			i = j - 1
+			continue
+		}
+		b.WriteByte(c)
+	}
+	return b.String(), n, nil
+}
+
+// copyFromMaxParams is the largest number of placeholders in a statement
+func copyFromMaxParams(engine string) int {
+	if engine == "sqlite" {
+		// SQLITE_MAX_VARIABLE_NUMBER since SQLite 3.32
+		return 32766
+	}
+	return 65535
+}
@@ -18,6 +18,13 @@ type Field struct {
 	Column  *plugin.Column
 	// EmbedFields contains the embedded fields that require scanning.
 	EmbedFields []string
+	// EmbedTypes contains the types of the embedded fields.
+	EmbedTypes []string
+	// EmbedKeys contains the positions of the embedded fields that make up
+	// the table's primary key.
+	EmbedKeys []int
+	// EmbedMany is set for sqlc.embed_many, which embeds a slice of structs.
+	EmbedMany bool
 }
 
 func (gf Field) Tag() string {
@@ -28,6 +35,10 @@ func (gf Field) HasSqlcSlice() bool {
 	return gf.Column.IsSqlcSlice
 }
 
+func (gf Field) HasSqlcOptional() bool {
+	return gf.Column.IsSqlcOptional
+}
+
 func TagsToString(tags map[string]string) string {
 	if len(tags) == 0 {
 		return ""
@@ -29,6 +29,12 @@ type tmplCtx struct {
 	SourceName string
 	Engine     string
 
+	// Merged is set when engines share the package. The files of each engine
+	// then leave out what is declared once for all of them, and helpers
+	// depending on the engine are suffixed with its name.
+	Merged  bool
+	Engines []string
+
 	EmitJSONTags              bool
 	JsonTagsIDUppercase       bool
 	EmitDBTags                bool
@@ -38,8 +44,17 @@ type tmplCtx struct {
 	EmitMethodsWithDBArgument bool
 	EmitEnumValidMethod       bool
 	EmitAllEnumValues         bool
+	EmitTxHelpers             bool
+	EmitReadReplica           bool
+	EmitHooks                 bool
 	UsesCopyFrom              bool
+	UsesCopyFromTx            bool
+	UsesLoadData              bool
+	CopyFromMaxParams         int
 	UsesBatch                 bool
+	UsesPatch                 bool
+	UsesSort                  bool
+	UsesPaginate              bool
 }
 
 func (t *tmplCtx) OutputQuery(sourceName string) bool {
@@ -53,6 +68,32 @@ func (t *tmplCtx) codegenDbarg() string {
 	return ""
 }
 
+// Called as a global method since subtemplate batchResultsStd does not have
+// access to the toplevel tmplCtx
+func (t *tmplCtx) codegenEmitEmptySlices() bool {
+	return t.EmitEmptySlices
+}
+
+func (t *tmplCtx) codegenEmitHooks() bool {
+	return t.EmitHooks
+}
+
+// codegenEngineSuffix tells apart the helpers of the engines sharing a package
+func (t *tmplCtx) codegenEngineSuffix() string {
+	if t.Merged {
+		return t.Engine
+	}
+	return ""
+}
+
+// codegenReceiver names the type the query methods are documented on
+func (t *tmplCtx) codegenReceiver() string {
+	if t.Merged {
+		return "DAL"
+	}
+	return t.Engine + "Access"
+}
+
 // Called as a global method since subtemplate queryCodeStdExec does not have
 // access to the toplevel tmplCtx
 func (t *tmplCtx) codegenEmitPreparedQueries() bool {
@@ -63,16 +104,18 @@ func (t *tmplCtx) codegenQueryMethod(q Query) string {
 	db := "q.db"
 	if t.EmitMethodsWithDBArgument {
 		db = "db"
+	} else if q.Replica {
+		db = "q.replica"
 	}
 
 	switch q.Cmd {
-	case ":one":
+	case ":one", ":opt":
 		if t.EmitPreparedQueries {
 			return "q.queryRow"
 		}
 		return db + ".QueryRowContext"
 
-	case ":many":
+	case ":many", ":iter", ":map", ":paginate":
 		if t.EmitPreparedQueries {
 			return "q.query"
 		}
@@ -88,11 +131,11 @@ func (t *tmplCtx) codegenQueryMethod(q Query) string {
 
 func (t *tmplCtx) codegenQueryRetval(q Query) (string, error) {
 	switch q.Cmd {
-	case ":one":
+	case ":one", ":opt":
 		return "row :=", nil
-	case ":many":
+	case ":many", ":iter", ":map", ":paginate":
 		return "rows, err :=", nil
-	case ":exec":
+	case ":exec", ":patch":
 		return "_, err :=", nil
 	case ":execrows", ":execlastid":
 		return "result, err :=", nil
@@ -119,6 +162,35 @@ func Generate(ctx context.Context, req *plugin.CodeGenRequest) (*plugin.CodeGenR
 }
 
 func generate(req *plugin.CodeGenRequest, enums []Enum, structs []Struct, queries []Query) (*plugin.CodeGenResponse, error) {
+	g, err := newGenerator(req, enums, structs, queries)
+	if err != nil {
+		return nil, err
+	}
+	if err := g.executeEngineFiles(); err != nil {
+		return nil, err
+	}
+	if err := g.execute(g.modelsFileName(), "modelsFile"); err != nil {
+		return nil, err
+	}
+	if req.Settings.Go.EmitInterface {
+		if err := g.execute(g.querierFileName(), "interfaceFile"); err != nil {
+			return nil, err
+		}
+	}
+	return g.response(), nil
+}
+
+// generator renders the templates of a Go package into output
+type generator struct {
+	req     *plugin.CodeGenRequest
+	tctx    *tmplCtx
+	imports *importer
+	tmpl    *template.Template
+	queries []Query
+	output  map[string]string
+}
+
+func newGenerator(req *plugin.CodeGenRequest, enums []Enum, structs []Struct, queries []Query) (*generator, error) {
 	i := &importer{
 		Settings: req.Settings,
 		Queries:  queries,
@@ -127,7 +199,7 @@ func generate(req *plugin.CodeGenRequest, enums []Enum, structs []Struct, querie
 	}
 
 	golang := req.Settings.Go
-	tctx := tmplCtx{
+	tctx := &tmplCtx{
 		EmitInterface:             golang.EmitInterface,
 		EmitJSONTags:              golang.EmitJsonTags,
 		JsonTagsIDUppercase:       golang.JsonTagsIdUppercase,
@@ -137,26 +209,60 @@ func generate(req *plugin.CodeGenRequest, enums []Enum, structs []Struct, querie
 		EmitMethodsWithDBArgument: golang.EmitMethodsWithDbArgument,
 		EmitEnumValidMethod:       golang.EmitEnumValidMethod,
 		EmitAllEnumValues:         golang.EmitAllEnumValues,
+		EmitTxHelpers:             golang.EmitTxHelpers,
+		EmitReadReplica:           golang.EmitReadReplica,
+		EmitHooks:                 golang.EmitHooks,
 		UsesCopyFrom:              usesCopyFrom(queries),
+		UsesCopyFromTx:            usesCopyFromTx(queries),
+		UsesLoadData:              usesLoadData(queries),
+		CopyFromMaxParams:         copyFromMaxParams(req.Settings.Engine),
 		UsesBatch:                 usesBatch(queries),
+		UsesPatch:                 usesPatch(queries),
+		UsesSort:                  usesSort(queries),
+		UsesPaginate:              usesPaginate(queries),
 		SQLDriver:                 parseDriver(golang.SqlPackage),
 		Q:                         "`",
 		Package:                   golang.Package,
 		Enums:                     enums,
 		Structs:                   structs,
 		SqlcVersion:               req.SqlcVersion,
+		Engine:                    sdk.Title(req.Settings.Engine),
+	}
+
+	if tctx.UsesCopyFrom && !tctx.SQLDriver.IsPGX() && req.Settings.Engine != "mysql" && req.Settings.Engine != "sqlite" {
+		return nil, errors.New(":copyfrom with database/sql is only supported by MySQL and SQLite")
+	}
+
+	if tctx.UsesPatch && tctx.SQLDriver.IsPGX() {
+		return nil, errors.New(":patch is only supported by database/sql")
 	}
 
-	if tctx.UsesCopyFrom && !tctx.SQLDriver.IsPGX() {
-		return nil, errors.New(":copyfrom is only supported by pgx")
+	if tctx.UsesSort && tctx.SQLDriver.IsPGX() {
+		return nil, errors.New("sqlc.sort is only supported by database/sql")
 	}
 
-	if tctx.UsesBatch && !tctx.SQLDriver.IsPGX() {
-		return nil, errors.New(":batch* commands are only supported by pgx")
+	if tctx.UsesPaginate && tctx.SQLDriver.IsPGX() {
+		return nil, errors.New(":paginate is only supported by database/sql")
+	}
+
+	if tctx.EmitTxHelpers && tctx.EmitMethodsWithDBArgument {
+		return nil, errors.New("emit_tx_helpers can't be used with emit_methods_with_db_argument")
+	}
+
+	if tctx.EmitReadReplica {
+		switch {
+		case tctx.SQLDriver.IsPGX():
+			return nil, errors.New("emit_read_replica is only supported by database/sql")
+		case tctx.EmitMethodsWithDBArgument:
+			return nil, errors.New("emit_read_replica can't be used with emit_methods_with_db_argument")
+		case tctx.EmitPreparedQueries:
+			return nil, errors.New("emit_read_replica can't be used with emit_prepared_queries, as statements are prepared on a single database")
+		}
 	}
 
 	funcMap := template.FuncMap{
 		"lowerTitle": sdk.LowerTitle,
+		"title":      sdk.Title,
 		"comment":    sdk.DoubleSlashComment,
 		"escape":     sdk.EscapeBacktick,
 		"imports":    i.Imports,
@@ -168,6 +274,12 @@ func generate(req *plugin.CodeGenRequest, enums []Enum, structs []Struct, querie
 		"emitPreparedQueries": tctx.codegenEmitPreparedQueries,
 		"queryMethod":         tctx.codegenQueryMethod,
 		"queryRetval":         tctx.codegenQueryRetval,
+		"emitEmptySlices":     tctx.codegenEmitEmptySlices,
+		"emitHooks":           tctx.codegenEmitHooks,
+		"engineSuffix":        tctx.codegenEngineSuffix,
+		"receiver":            tctx.codegenReceiver,
+		"implName":            tctx.codegenImplName,
+		"hooked":              tctx.codegenHooked,
 	}
 
 	tmpl := template.Must(
@@ -180,108 +292,129 @@ func generate(req *plugin.CodeGenRequest, enums []Enum, structs []Struct, querie
 			),
 	)
 
-	output := map[string]string{}
-
-	execute := func(name, templateName string) error {
-		ipt := i.Imports(name)
-		replacedQueries := replaceConflictedArg(ipt, queries)
-
-		var b bytes.Buffer
-		w := bufio.NewWriter(&b)
-		tctx.SourceName = name
-		tctx.GoQueries = replacedQueries
-		tctx.Engine = sdk.Title(req.Settings.Engine)
-		err := tmpl.ExecuteTemplate(w, templateName, &tctx)
-		w.Flush()
-		if err != nil {
-			return err
-		}
-		puff, err := imports.Process("", b.Bytes(), &imports.Options{Comments: true, TabIndent: true, TabWidth: 8, FormatOnly: false})
-		if nil != err {
-			return err
-		}
-		code, err := format.Source(puff)
-		if err != nil {
-			fmt.Println(b.String())
-			return fmt.Errorf("source error: %w", err)
-		}
+	return &generator{
+		req:     req,
+		tctx:    tctx,
+		imports: i,
+		tmpl:    tmpl,
+		queries: queries,
+		output:  map[string]string{},
+	}, nil
+}
 
-		if templateName == "queryFile" && golang.OutputFilesSuffix != "" {
-			name += golang.OutputFilesSuffix
-		}
-		if templateName == "queryFile" || templateName == "dbFile" {
-			name = strings.ReplaceAll(name, ".go", "")
-			name = fmt.Sprintf("%s.%s", strings.ReplaceAll(name, ".sql", ""), req.Settings.Engine)
-		}
-		if !strings.HasSuffix(name, ".go") {
-			name += ".go"
-		}
-		output[name] = string(code)
-		return nil
+func (g *generator) execute(name, templateName string) error {
+	golang := g.req.Settings.Go
+	ipt := g.imports.Imports(name)
+	replacedQueries := replaceConflictedArg(ipt, g.queries)
+
+	var b bytes.Buffer
+	w := bufio.NewWriter(&b)
+	g.tctx.SourceName = name
+	g.tctx.GoQueries = replacedQueries
+	err := g.tmpl.ExecuteTemplate(w, templateName, g.tctx)
+	w.Flush()
+	if err != nil {
+		return err
+	}
+	puff, err := imports.Process("", b.Bytes(), &imports.Options{Comments: true, TabIndent: true, TabWidth: 8, FormatOnly: false})
+	if nil != err {
+		return err
+	}
+	code, err := format.Source(puff)
+	if err != nil {
+		fmt.Println(b.String())
+		return fmt.Errorf("source error: %w", err)
 	}
 
-	dbFileName := "db.go"
-	if golang.OutputDbFileName != "" {
-		dbFileName = golang.OutputDbFileName
+	if templateName == "queryFile" && golang.OutputFilesSuffix != "" {
+		name += golang.OutputFilesSuffix
 	}
-	modelsFileName := "models.go"
-	if golang.OutputModelsFileName != "" {
-		modelsFileName = golang.OutputModelsFileName
+	engineFile := templateName == "queryFile" || templateName == "dbFile"
+	if g.tctx.Merged && (templateName == "copyfromFile" || templateName == "batchFile") {
+		// Every engine sharing the package has its own
+		engineFile = true
 	}
-	querierFileName := "querier.go"
-	if golang.OutputQuerierFileName != "" {
-		querierFileName = golang.OutputQuerierFileName
+	if engineFile {
+		name = strings.ReplaceAll(name, ".go", "")
+		name = fmt.Sprintf("%s.%s", strings.ReplaceAll(name, ".sql", ""), g.req.Settings.Engine)
 	}
-	copyfromFileName := "copyfrom.go"
-	// TODO(Jille): Make this configurable.
-
-	batchFileName := "batch.go"
-	if golang.OutputBatchFileName != "" {
-		batchFileName = golang.OutputBatchFileName
+	if !strings.HasSuffix(name, ".go") {
+		name += ".go"
 	}
+	g.output[name] = string(code)
+	return nil
+}
 
-	if err := execute(dbFileName, "dbFile"); err != nil {
-		return nil, err
-	}
-	if err := execute(modelsFileName, "modelsFile"); err != nil {
-		return nil, err
+// executeEngineFiles renders the files of the engine's Access type: its
+// database handle and the methods of its queries
+func (g *generator) executeEngineFiles() error {
+	golang := g.req.Settings.Go
+	dbFileName := "db.go"
+	if golang.OutputDbFileName != "" {
+		dbFileName = golang.OutputDbFileName
 	}
-	if golang.EmitInterface {
-		if err := execute(querierFileName, "interfaceFile"); err != nil {
-			return nil, err
-		}
+	if err := g.execute(dbFileName, "dbFile"); err != nil {
+		return err
 	}
-	if tctx.UsesCopyFrom {
-		if err := execute(copyfromFileName, "copyfromFile"); err != nil {
-			return nil, err
+	if g.tctx.UsesCopyFrom {
+		if err := g.execute(g.copyfromFileName(), "copyfromFile"); err != nil {
+			return err
 		}
 	}
-	if tctx.UsesBatch {
-		if err := execute(batchFileName, "batchFile"); err != nil {
-			return nil, err
+	if g.tctx.UsesBatch {
+		if err := g.execute(g.batchFileName(), "batchFile"); err != nil {
+			return err
 		}
 	}
 
 	files := map[string]struct{}{}
-	for _, gq := range queries {
+	for _, gq := range g.queries {
 		files[gq.SourceName] = struct{}{}
 	}
 
 	for source := range files {
-		if err := execute(source, "queryFile"); err != nil {
-			return nil, err
+		if err := g.execute(source, "queryFile"); err != nil {
+			return err
 		}
 	}
-	resp := plugin.CodeGenResponse{}
+	return nil
+}
 
-	for filename, code := range output {
+func (g *generator) modelsFileName() string {
+	if g.req.Settings.Go.OutputModelsFileName != "" {
+		return g.req.Settings.Go.OutputModelsFileName
+	}
+	return "models.go"
+}
+
+func (g *generator) querierFileName() string {
+	if g.req.Settings.Go.OutputQuerierFileName != "" {
+		return g.req.Settings.Go.OutputQuerierFileName
+	}
+	return "querier.go"
+}
+
+func (g *generator) copyfromFileName() string {
+	// TODO(Jille): Make this configurable.
+	return "copyfrom.go"
+}
+
+func (g *generator) batchFileName() string {
+	if g.req.Settings.Go.OutputBatchFileName != "" {
+		return g.req.Settings.Go.OutputBatchFileName
+	}
+	return "batch.go"
+}
+
+func (g *generator) response() *plugin.CodeGenResponse {
+	resp := plugin.CodeGenResponse{}
+	for filename, code := range g.output {
 		resp.Files = append(resp.Files, &plugin.File{
 			Name:     filename,
 			Contents: []byte(code),
 		})
 	}
-
-	return &resp, nil
+	return &resp
 }
 
 func usesCopyFrom(queries []Query) bool {
@@ -293,6 +426,51 @@ func usesCopyFrom(queries []Query) bool {
 	return false
 }
 
+func usesCopyFromTx(queries []Query) bool {
+	for _, q := range queries {
+		if q.CopyFrom != nil && q.CopyFrom.Tx {
+			return true
+		}
+	}
+	return false
+}
+
+func usesLoadData(queries []Query) bool {
+	for _, q := range queries {
+		if q.CopyFrom != nil && q.CopyFrom.LoadData != "" {
+			return true
+		}
+	}
+	return false
+}
+
+func usesPatch(queries []Query) bool {
+	for _, q := range queries {
+		if q.Cmd == metadata.CmdPatch {
+			return true
+		}
+	}
+	return false
+}
+
+func usesPaginate(queries []Query) bool {
+	for _, q := range queries {
+		if q.Cmd == metadata.CmdPaginate {
+			return true
+		}
+	}
+	return false
+}
+
+func usesSort(queries []Query) bool {
+	for _, q := range queries {
+		if q.Sort != nil {
+			return true
+		}
+	}
+	return false
+}
+
 func usesBatch(queries []Query) bool {
 	for _, q := range queries {
 		for _, cmd := range []string{metadata.CmdBatchExec, metadata.CmdBatchMany, metadata.CmdBatchOne} {
@@ -44,9 +44,18 @@ func goType(req *plugin.CodeGenRequest, col *plugin.Column) string {
 			if col.IsSqlcSlice {
 				return "[]" + oride.GoType.TypeName
 			}
+			if col.IsSqlcOptional {
+				return "*" + oride.GoType.TypeName
+			}
 			return oride.GoType.TypeName
 		}
 	}
+	if col.IsSqlcOptional {
+		// nil drops the predicate, so the value itself is never NULL
+		notNull := col.CloneVT()
+		notNull.NotNull = true
+		return "*" + goInnerType(req, notNull)
+	}
 	typ := goInnerType(req, col)
 	if col.IsArray || col.IsSqlcSlice {
 		return "[]" + typ
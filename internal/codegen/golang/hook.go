@@ -0,0 +1,115 @@
+package golang
+
+import (
+	"strings"
+
+	"github.com/ZeyuRemtes/sqlc/internal/metadata"
+)
+
+// Hooked is the exported method of a query generated with emit_hooks. It calls
+// the hooks around the unexported method running the query, which it passes
+// its parameters to.
+type Hooked struct {
+	Receiver string
+	Name     string
+	Impl     string // the method running the query
+	Info     string // the QueryInfo variable of the query
+	Params   string // the parameters following ctx
+	Args     string // the arguments passing them on
+	Results  string // the named results, ending with err
+}
+
+// codegenImplName names the method running a query, which is unexported when
+// an exported method calls the hooks around it
+func (t *tmplCtx) codegenImplName(q Query) string {
+	if !t.EmitHooks || usesBatch([]Query{q}) {
+		return q.MethodName
+	}
+	return "run" + q.MethodName
+}
+
+// codegenHooked returns the exported method calling the hooks of a query, or
+// nil when hooks aren't generated for it. The results of :batch queries are
+// read after their method returns, so they call the hooks themselves.
+func (t *tmplCtx) codegenHooked(q Query) *Hooked {
+	if !t.EmitHooks || usesBatch([]Query{q}) {
+		return nil
+	}
+	h := &Hooked{
+		Receiver: "*" + t.Engine + "Access",
+		Name:     q.MethodName,
+		Impl:     t.codegenImplName(q),
+		Info:     q.ConstantName + "Info",
+	}
+	if t.SQLDriver.IsPGX() {
+		h.Receiver = "*Queries"
+	}
+
+	var params, args []string
+	add := func(param, arg string) {
+		params = append(params, param)
+		args = append(args, arg)
+	}
+	if t.EmitMethodsWithDBArgument {
+		add("db DBTX", "db")
+	}
+	if q.Cmd == metadata.CmdCopyFrom {
+		add(q.Arg.SlicePair(), q.Arg.Name)
+	} else {
+		if q.Sort != nil && q.Cmd != metadata.CmdPaginate {
+			add("sortBy "+q.Sort.Type, "sortBy")
+			add("sortDir SortDirection", "sortDir")
+		}
+		if pair := q.Arg.Pair(); pair != "" {
+			params = append(params, pair)
+			args = append(args, q.Arg.pairNames()...)
+		}
+		switch q.Cmd {
+		case metadata.CmdIter:
+			add("fn func("+q.Ret.DefineType()+") error", "fn")
+		case metadata.CmdPaginate:
+			add("cursor "+q.Paginate.Cursor, "cursor")
+			add("limit int", "limit")
+		}
+	}
+	h.Params = strings.Join(params, ", ")
+	h.Args = strings.Join(args, ", ")
+
+	switch q.Cmd {
+	case metadata.CmdOne:
+		h.Results = "_ " + q.Ret.DefineType() + ", "
+	case metadata.CmdOpt:
+		h.Results = "_ " + q.Ret.DefineType() + ", _ bool, "
+	case metadata.CmdMany:
+		h.Results = "_ []" + q.Ret.DefineType() + ", "
+	case metadata.CmdMap:
+		h.Results = "_ map[" + q.MapKey.Type + "]" + q.Ret.DefineType() + ", "
+	case metadata.CmdPaginate:
+		h.Results = "_ " + q.Paginate.Type + ", "
+	case metadata.CmdExecRows, metadata.CmdExecLastId, metadata.CmdCopyFrom:
+		h.Results = "_ int64, "
+	case metadata.CmdExecResult:
+		if t.SQLDriver.IsPGX() {
+			h.Results = "_ pgconn.CommandTag, "
+		} else {
+			h.Results = "_ sql.Result, "
+		}
+	}
+	h.Results += "err error"
+	return h
+}
+
+// pairNames returns the names of the parameters declared by Pair
+func (v QueryValue) pairNames() []string {
+	if v.isEmpty() {
+		return nil
+	}
+	if !v.EmitStruct() && v.IsStruct() {
+		names := make([]string, 0, len(v.Struct.Fields))
+		for _, f := range v.Struct.Fields {
+			names = append(names, toLowerCase(f.Name))
+		}
+		return names
+	}
+	return []string{v.Name}
+}
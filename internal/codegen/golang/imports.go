@@ -126,9 +126,20 @@ func (i *importer) dbImports() fileImports {
 		pkg = append(pkg, ImportSpec{Path: "github.com/jackc/pgx/v5"})
 	default:
 		std = append(std, ImportSpec{Path: "database/sql"})
-		if i.Settings.Go.EmitPreparedQueries {
+		if i.Settings.Go.EmitPreparedQueries || i.Settings.Go.EmitTxHelpers {
 			std = append(std, ImportSpec{Path: "fmt"})
 		}
+		if i.Settings.Go.EmitTxHelpers {
+			switch i.Settings.Engine {
+			case "mysql":
+				pkg = append(pkg, ImportSpec{Path: "github.com/go-sql-driver/mysql"})
+			case "sqlite":
+				std = append(std, ImportSpec{Path: "strings"})
+			}
+		}
+	}
+	if i.Settings.Go.EmitTxHelpers {
+		std = append(std, ImportSpec{Path: "errors"})
 	}
 
 	sort.Slice(std, func(i, j int) bool { return std[i].Path < std[j].Path })
@@ -252,6 +263,18 @@ func (i *importer) interfaceImports() fileImports {
 	})
 
 	std["context"] = struct{}{}
+	// The interface file always imports these
+	delete(std, "database/sql")
+	delete(std, "io")
+
+	if i.Settings.Go.EmitTxHelpers {
+		switch parseDriver(i.Settings.Go.SqlPackage) {
+		case SQLDriverPGXV4:
+			pkg[ImportSpec{Path: "github.com/jackc/pgx/v4"}] = struct{}{}
+		case SQLDriverPGXV5:
+			pkg[ImportSpec{Path: "github.com/jackc/pgx/v5"}] = struct{}{}
+		}
+	}
 
 	return sortedImports(std, pkg)
 }
@@ -358,10 +381,10 @@ func (i *importer) queryImports(filename string) fileImports {
 		return false
 	}
 
-	// Search for sqlc.slice() calls
+	// Search for queries whose text is built at run time
 	sqlcSliceScan := func() bool {
 		for _, q := range gq {
-			if q.Arg.HasSqlcSlices() {
+			if q.Arg.HasSqlcSlices() || q.Arg.HasSqlcOptionals() || q.Sort != nil || q.Paginate != nil {
 				return true
 			}
 		}
@@ -407,6 +430,21 @@ func (i *importer) copyfromImports() fileImports {
 	})
 
 	std["context"] = struct{}{}
+	if !parseDriver(i.Settings.Go.SqlPackage).IsPGX() && len(copyFromQueries) > 0 {
+		std["strings"] = struct{}{}
+		if usesCopyFromTx(copyFromQueries) {
+			std["database/sql"] = struct{}{}
+		}
+		if usesLoadData(copyFromQueries) {
+			std["bufio"] = struct{}{}
+			std["database/sql/driver"] = struct{}{}
+			std["fmt"] = struct{}{}
+			std["io"] = struct{}{}
+			std["sync/atomic"] = struct{}{}
+			std["time"] = struct{}{}
+			pkg[ImportSpec{Path: "github.com/go-sql-driver/mysql"}] = struct{}{}
+		}
+	}
 
 	return sortedImports(std, pkg)
 }
@@ -456,6 +494,8 @@ func (i *importer) batchImports() fileImports {
 		pkg[ImportSpec{Path: "github.com/jackc/pgx/v4"}] = struct{}{}
 	case SQLDriverPGXV5:
 		pkg[ImportSpec{Path: "github.com/jackc/pgx/v5"}] = struct{}{}
+	default:
+		std["database/sql"] = struct{}{}
 	}
 
 	return sortedImports(std, pkg)
@@ -0,0 +1,191 @@
+package golang
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/ZeyuRemtes/sqlc/internal/plugin"
+)
+
+// GenerateMerged generates a single Go package for sql blocks of different
+// engines sharing an output directory. The models and the DAL interface are
+// generated once from the union of the catalogs, next to the Access type and
+// query files of each engine. NewDAL returns the Access type of an engine by
+// its name.
+//
+// The blocks must generate the same DAL methods, which the shared files are
+// rendered from using the settings of the first block.
+func GenerateMerged(ctx context.Context, reqs []*plugin.CodeGenRequest) (*plugin.CodeGenResponse, error) {
+	first := reqs[0]
+	engines := make([]string, 0, len(reqs))
+	allEnums := make([][]Enum, 0, len(reqs))
+	allStructs := make([][]Struct, 0, len(reqs))
+	allQueries := make([][]Query, 0, len(reqs))
+	for _, req := range reqs {
+		engine := req.Settings.Engine
+		for _, e := range engines {
+			if e == engine {
+				return nil, fmt.Errorf("engine %s is used by more than one sql block of package %s", engine, first.Settings.Go.Package)
+			}
+		}
+		if req.Settings.Go.Package != first.Settings.Go.Package {
+			return nil, fmt.Errorf("package is named %s for %s, but %s for %s", first.Settings.Go.Package, first.Settings.Engine, req.Settings.Go.Package, engine)
+		}
+		if req.Settings.Go.EmitTxHelpers != first.Settings.Go.EmitTxHelpers {
+			return nil, fmt.Errorf("emit_tx_helpers is %t for %s, but %t for %s", first.Settings.Go.EmitTxHelpers, first.Settings.Engine, req.Settings.Go.EmitTxHelpers, engine)
+		}
+		if req.Settings.Go.EmitReadReplica != first.Settings.Go.EmitReadReplica {
+			return nil, fmt.Errorf("emit_read_replica is %t for %s, but %t for %s", first.Settings.Go.EmitReadReplica, first.Settings.Engine, req.Settings.Go.EmitReadReplica, engine)
+		}
+		if req.Settings.Go.EmitHooks != first.Settings.Go.EmitHooks {
+			return nil, fmt.Errorf("emit_hooks is %t for %s, but %t for %s", first.Settings.Go.EmitHooks, first.Settings.Engine, req.Settings.Go.EmitHooks, engine)
+		}
+		if parseDriver(req.Settings.Go.SqlPackage).IsPGX() {
+			return nil, fmt.Errorf("engines sharing a package are only supported by database/sql")
+		}
+		engines = append(engines, engine)
+
+		enums := buildEnums(req)
+		structs := buildStructs(req)
+		queries, err := buildQueries(req, structs)
+		if err != nil {
+			return nil, err
+		}
+		if req.Settings.Go.OmitUnusedStructs {
+			enums, structs = filterUnusedStructs(enums, structs, queries)
+		}
+		allEnums = append(allEnums, enums)
+		allStructs = append(allStructs, structs)
+		allQueries = append(allQueries, queries)
+	}
+
+	enums, structs, err := mergeModels(engines, allEnums, allStructs)
+	if err != nil {
+		return nil, err
+	}
+
+	output := map[string]string{}
+	var union []Query
+	for i, req := range reqs {
+		g, err := newGenerator(req, enums, structs, allQueries[i])
+		if err != nil {
+			return nil, err
+		}
+		g.tctx.Merged = true
+		g.output = output
+		if err := g.executeEngineFiles(); err != nil {
+			return nil, err
+		}
+		union = append(union, allQueries[i]...)
+	}
+
+	g, err := newGenerator(first, enums, structs, allQueries[0])
+	if err != nil {
+		return nil, err
+	}
+	g.tctx.Merged = true
+	g.tctx.Engines = engines
+	g.output = output
+	// Helpers are shared by every engine using them
+	g.imports.Queries = union
+	g.tctx.UsesCopyFromTx = usesCopyFromTx(union)
+	g.tctx.UsesLoadData = usesLoadData(union)
+
+	if err := g.execute(g.modelsFileName(), "modelsFile"); err != nil {
+		return nil, err
+	}
+	// NewDAL returns the DAL interface, so it's always generated
+	if err := g.execute(g.querierFileName(), "interfaceFile"); err != nil {
+		return nil, err
+	}
+	if err := g.execute("dal.go", "dalFile"); err != nil {
+		return nil, err
+	}
+	if g.tctx.UsesCopyFromTx || g.tctx.UsesLoadData {
+		if err := g.execute(g.copyfromFileName(), "copyfromSharedFile"); err != nil {
+			return nil, err
+		}
+	}
+	if usesBatch(union) {
+		if err := g.execute(g.batchFileName(), "batchSharedFile"); err != nil {
+			return nil, err
+		}
+	}
+	return g.response(), nil
+}
+
+// mergeModels merges the enums and structs generated for each engine. Structs
+// of the same name get the fields of all of them, which have to be of the same
+// type, and enums of the same name have to agree on their values.
+func mergeModels(engines []string, enums [][]Enum, structs [][]Struct) ([]Enum, []Struct, error) {
+	var errs []error
+
+	var mergedEnums []Enum
+	enumIdx := map[string]int{}
+	enumFrom := map[string]int{}
+	for i, list := range enums {
+		for _, e := range list {
+			idx, ok := enumIdx[e.Name]
+			if !ok {
+				enumIdx[e.Name] = len(mergedEnums)
+				enumFrom[e.Name] = i
+				mergedEnums = append(mergedEnums, e)
+				continue
+			}
+			if mine, theirs := enumValues(mergedEnums[idx]), enumValues(e); mine != theirs {
+				errs = append(errs, fmt.Errorf("enum %s has values (%s) for %s, but (%s) for %s", e.Name, mine, engines[enumFrom[e.Name]], theirs, engines[i]))
+			}
+		}
+	}
+
+	var mergedStructs []Struct
+	structIdx := map[string]int{}
+	fieldFrom := map[string]map[string]int{}
+	for i, list := range structs {
+		for _, s := range list {
+			idx, ok := structIdx[s.Name]
+			if !ok {
+				structIdx[s.Name] = len(mergedStructs)
+				fieldFrom[s.Name] = map[string]int{}
+				for _, f := range s.Fields {
+					fieldFrom[s.Name][f.Name] = i
+				}
+				s.Fields = append([]Field(nil), s.Fields...)
+				mergedStructs = append(mergedStructs, s)
+				continue
+			}
+			merged := &mergedStructs[idx]
+			for _, f := range s.Fields {
+				j, ok := fieldFrom[s.Name][f.Name]
+				if !ok {
+					fieldFrom[s.Name][f.Name] = i
+					merged.Fields = append(merged.Fields, f)
+					continue
+				}
+				for _, m := range merged.Fields {
+					if m.Name == f.Name && m.Type != f.Type {
+						errs = append(errs, fmt.Errorf("model %s: field %s is %s for %s, but %s for %s", s.Name, f.Name, m.Type, engines[j], f.Type, engines[i]))
+					}
+				}
+			}
+		}
+	}
+
+	if len(errs) > 0 {
+		return nil, nil, errors.Join(errs...)
+	}
+	sort.Slice(mergedEnums, func(i, j int) bool { return mergedEnums[i].Name < mergedEnums[j].Name })
+	sort.Slice(mergedStructs, func(i, j int) bool { return mergedStructs[i].Name < mergedStructs[j].Name })
+	return mergedEnums, mergedStructs, nil
+}
+
+func enumValues(e Enum) string {
+	values := make([]string, 0, len(e.Constants))
+	for _, c := range e.Constants {
+		values = append(values, c.Value)
+	}
+	return strings.Join(values, ", ")
+}
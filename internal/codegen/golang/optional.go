@@ -0,0 +1,109 @@
+package golang
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Optional is a predicate wrapped in sqlc.optional. When its parameter is nil
+// the predicate is swapped for Fallback, which is always true. The parameter
+// stays in the query, and is still passed as NULL, so that the arguments keep
+// their positions.
+type Optional struct {
+	Predicate string
+	Fallback  string
+}
+
+// optionals finds the predicates the compiler marked with an
+// `/*OPTIONAL:name*/` comment, keyed by parameter name
+func optionals(sql string, escapes bool) (map[string]Optional, error) {
+	const marker = "(/*OPTIONAL:"
+	var out map[string]Optional
+	for offset := 0; ; {
+		i := strings.Index(sql[offset:], marker)
+		if i < 0 {
+			return out, nil
+		}
+		start := offset + i
+		end := strings.Index(sql[start:], "*/")
+		name := sql[start+len(marker) : start+end]
+
+		stop, placeholder := closeParen(sql, start, escapes)
+		if stop < 0 || placeholder == "" {
+			return nil, fmt.Errorf("malformed sqlc.optional predicate for %q", name)
+		}
+		if out == nil {
+			out = map[string]Optional{}
+		}
+		out[name] = Optional{
+			Predicate: sql[start : stop+1],
+			Fallback:  "(" + placeholder + " IS NULL)",
+		}
+		offset = stop + 1
+	}
+}
+
+// closeParen returns the position of the parenthesis that closes the one at
+// start, and the first parameter placeholder in between. Quoted strings and
+// identifiers are skipped. With escapes, a backslash escapes the next
+// character of a string, as it does in MySQL.
+func closeParen(sql string, start int, escapes bool) (int, string) {
+	var depth int
+	var placeholder string
+	for i := start; i < len(sql); i++ {
+		switch c := sql[i]; c {
+		case '\'', '"', '`':
+			i = closeQuote(sql, i, escapes && c != '`')
+			if i < 0 {
+				return -1, ""
+			}
+		case '/':
+			if strings.HasPrefix(sql[i:], "/*") {
+				end := strings.Index(sql[i:], "*/")
+				if end < 0 {
+					return -1, ""
+				}
+				i += end + 1
+			}
+		case '(':
+			depth++
+		case ')':
+			depth--
+			if depth == 0 {
+				return i, placeholder
+			}
+		case '?', '$':
+			if placeholder != "" {
+				continue
+			}
+			j := i + 1
+			for j < len(sql) && sql[j] >= '0' && sql[j] <= '9' {
+				j++
+			}
+			if c == '?' || j > i+1 {
+				placeholder = sql[i:j]
+			}
+		}
+	}
+	return -1, ""
+}
+
+// closeQuote returns the position of the quote that closes the one at start.
+// A doubled quote stands for the quote itself.
+func closeQuote(sql string, start int, escapes bool) int {
+	quote := sql[start]
+	for i := start + 1; i < len(sql); i++ {
+		switch sql[i] {
+		case '\\':
+			if escapes {
+				i++
+			}
+		case quote:
+			if i+1 >= len(sql) || sql[i+1] != quote {
+				return i
+			}
+			i++
+		}
+	}
+	return -1
+}
@@ -0,0 +1,118 @@
+package golang
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/ZeyuRemtes/sqlc/internal/codegen/sdk"
+	"github.com/ZeyuRemtes/sqlc/internal/plugin"
+)
+
+// Paginate describes the page type and keyset predicate of a :paginate query
+type Paginate struct {
+	Type      string // the page returned by the query
+	Cursor    string // the opaque cursor of a page
+	KeyType   string // the values of the last row of a page, stored in its cursor
+	Keys      []PageKey
+	Marker    string // the comment the compiler left where the predicate goes
+	Predicate string // only matches the rows after the cursor
+	Args      string // the parameters of the predicate
+}
+
+// PageKey is an ORDER BY column of a :paginate query
+type PageKey struct {
+	Name  string
+	Type  string
+	Value string // reads the column from the last row of a page
+}
+
+// paginate reads the `/*PAGINATE:AND:ref DESC 2,...*/` comment the compiler
+// left in a :paginate query
+func paginate(req *plugin.CodeGenRequest, query *plugin.Query, q Query) (*Paginate, error) {
+	const marker = "/*PAGINATE:"
+	start := strings.Index(query.Text, marker)
+	if start < 0 {
+		return nil, fmt.Errorf("missing :paginate clause")
+	}
+	end := strings.Index(query.Text[start:], "*/")
+	if end < 0 {
+		return nil, fmt.Errorf("malformed :paginate clause")
+	}
+	connector, list, ok := strings.Cut(query.Text[start+len(marker):start+end], ":")
+	if !ok {
+		return nil, fmt.Errorf("malformed :paginate clause")
+	}
+
+	p := &Paginate{
+		Type:    q.MethodName + "Page",
+		Cursor:  q.MethodName + "Cursor",
+		KeyType: sdk.LowerTitle(q.MethodName) + "Key",
+		Marker:  query.Text[start : start+end+len("*/")],
+	}
+	var refs []string
+	var desc []bool
+	var args []string
+	for _, entry := range strings.Split(list, ",") {
+		parts := strings.Fields(entry)
+		if len(parts) != 3 {
+			return nil, fmt.Errorf("malformed :paginate clause")
+		}
+		i, err := strconv.Atoi(parts[2])
+		if err != nil || i >= len(query.Columns) {
+			return nil, fmt.Errorf("malformed :paginate clause")
+		}
+		key := PageKey{
+			Name:  StructName(columnName(query.Columns[i], i), req.Settings),
+			Type:  q.Ret.Type(),
+			Value: "last",
+		}
+		if q.Ret.Struct != nil {
+			field := q.Ret.Struct.Fields[i]
+			key.Name, key.Type, key.Value = field.Name, field.Type, "last."+field.Name
+		}
+		p.Keys = append(p.Keys, key)
+		refs = append(refs, parts[0])
+		desc = append(desc, parts[1] == "DESC")
+		args = append(args, "key."+key.Name)
+	}
+
+	// Rows after the cursor compare greater for ascending columns and lower
+	// for descending ones. When every column goes the same way, both engines
+	// compare the columns as a row value.
+	same := true
+	for _, d := range desc {
+		same = same && d == desc[0]
+	}
+	switch {
+	case len(refs) == 1:
+		p.Predicate = fmt.Sprintf("%s %s ?", refs[0], after(desc[0]))
+		p.Args = args[0]
+	case same:
+		p.Predicate = fmt.Sprintf("(%s) %s (%s)", strings.Join(refs, ", "), after(desc[0]), strings.Repeat(", ?", len(refs))[2:])
+		p.Args = strings.Join(args, ", ")
+	default:
+		var terms, termArgs []string
+		for i := range refs {
+			var conds []string
+			for j := 0; j < i; j++ {
+				conds = append(conds, refs[j]+" = ?")
+				termArgs = append(termArgs, args[j])
+			}
+			conds = append(conds, fmt.Sprintf("%s %s ?", refs[i], after(desc[i])))
+			termArgs = append(termArgs, args[i])
+			terms = append(terms, "("+strings.Join(conds, " AND ")+")")
+		}
+		p.Predicate = "(" + strings.Join(terms, " OR ") + ")"
+		p.Args = strings.Join(termArgs, ", ")
+	}
+	p.Predicate = connector + " " + p.Predicate
+	return p, nil
+}
+
+func after(desc bool) string {
+	if desc {
+		return "<"
+	}
+	return ">"
+}
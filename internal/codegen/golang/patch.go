@@ -0,0 +1,60 @@
+package golang
+
+import (
+	"fmt"
+	"strings"
+)
+
+// PatchSet is the part of the SET list of a :patch query that is built at
+// run time, from the columns whose parameter is set
+type PatchSet struct {
+	Text        string            // the marked assignments in the query
+	Assignments map[string]string // `column = ?`, keyed by parameter name
+}
+
+// Assignment returns the assignment of a parameter
+func (p PatchSet) Assignment(name string) string {
+	return p.Assignments[name]
+}
+
+// patchSet finds the assignments the compiler marked with a
+// `/*PATCH:name*/` comment
+func patchSet(sql string) (*PatchSet, error) {
+	const marker = "/*PATCH:"
+	set := &PatchSet{Assignments: map[string]string{}}
+	start, end := -1, -1
+	for offset := 0; ; {
+		i := strings.Index(sql[offset:], marker)
+		if i < 0 {
+			break
+		}
+		begin := offset + i
+		if end >= 0 && strings.Trim(sql[end:begin], ", \t\r\n") != "" {
+			return nil, fmt.Errorf("the columns set to parameters must be next to each other")
+		}
+		nameEnd := strings.Index(sql[begin:], "*/")
+		name := sql[begin+len(marker) : begin+nameEnd]
+		assign := begin + nameEnd + len("*/")
+		param := strings.IndexByte(sql[assign:], '?')
+		if param < 0 {
+			return nil, fmt.Errorf("malformed assignment of %q", name)
+		}
+		if start < 0 {
+			start = begin
+		}
+		end = assign + param + 1
+		set.Assignments[name] = sql[assign:end]
+		offset = end
+	}
+	if start < 0 {
+		return nil, fmt.Errorf(":patch requires a column set to a parameter")
+	}
+	set.Text = sql[start:end]
+	return set, nil
+}
+
+// patchType is the type of a :patch parameter, which tells apart a column
+// that isn't written from one that is set to NULL
+func patchType(typ string) string {
+	return "Patch[" + strings.TrimPrefix(typ, "*") + "]"
+}
@@ -154,6 +154,20 @@ func (v QueryValue) HasSqlcSlices() bool {
 	return false
 }
 
+// HasSqlcOptionals is true when the query text depends on which sqlc.optional
+// parameters are nil
+func (v QueryValue) HasSqlcOptionals() bool {
+	if v.Struct == nil {
+		return v.Column != nil && v.Column.IsSqlcOptional
+	}
+	for _, v := range v.Struct.Fields {
+		if v.Column.IsSqlcOptional {
+			return true
+		}
+	}
+	return false
+}
+
 func (v QueryValue) Scan() string {
 	var out []string
 	if v.Struct == nil {
@@ -165,6 +179,14 @@ func (v QueryValue) Scan() string {
 	} else {
 		for _, f := range v.Struct.Fields {
 
+			// children are scanned apart from the row, see Group
+			if f.EmbedMany {
+				for _, embed := range f.EmbedFields {
+					out = append(out, "&"+embedManyVar(f)+"."+embed)
+				}
+				continue
+			}
+
 			// append any embedded fields
 			if len(f.EmbedFields) > 0 {
 				for _, embed := range f.EmbedFields {
@@ -209,12 +231,92 @@ type Query struct {
 	Ret          QueryValue
 	Arg          QueryValue
 	// Used for :copyfrom
-	Table *plugin.Identifier
+	Table    *plugin.Identifier
+	CopyFrom *CopyFrom
+	// Used for :batch* with database/sql
+	BatchTx bool
+	// Used for queries with the chunk option
+	Chunk *Chunk
+	// Used for :map
+	MapKey *MapKey
+	// Used for sqlc.embed_many
+	Group *Group
+	// Used for sqlc.optional, keyed by parameter name
+	Optionals map[string]Optional
+	// Used for :patch
+	PatchSet *PatchSet
+	// Used for sqlc.sort
+	Sort *Sort
+	// Used for :paginate
+	Paginate *Paginate
+	// Used with emit_read_replica, for queries run on the replica
+	Replica bool
+}
+
+// SortPair returns the leading method parameters that pick the sort order of
+// a query using sqlc.sort
+func (q Query) SortPair() string {
+	if q.Sort == nil {
+		return ""
+	}
+	return "sortBy " + q.Sort.Type + ", sortDir SortDirection, "
+}
+
+// Optional returns the sqlc.optional predicate of a parameter
+func (q Query) Optional(name string) Optional {
+	return q.Optionals[name]
+}
+
+// Group describes how the rows of a query using sqlc.embed_many are folded
+// into one result per parent
+type Group struct {
+	KeyType string // identifies a parent
+	Key     string // the parent of a scanned row
+	Field   Field  // the sqlc.embed_many field
+}
+
+// Var is the variable the children's columns are scanned into. They are all
+// NULL when a LEFT JOIN finds no children.
+func (g Group) Var() string {
+	return embedManyVar(g.Field)
+}
+
+// Columns returns the embedded fields, which are scanned as pointers
+func (g Group) Columns() []Field {
+	fields := make([]Field, len(g.Field.EmbedFields))
+	for i, name := range g.Field.EmbedFields {
+		fields[i] = Field{Name: name, Type: g.Field.EmbedTypes[i]}
+	}
+	return fields
+}
+
+// Null returns a condition that holds when a row has no child
+func (g Group) Null() string {
+	conds := make([]string, len(g.Field.EmbedFields))
+	for i, name := range g.Field.EmbedFields {
+		conds[i] = g.Var() + "." + name + " == nil"
+	}
+	return strings.Join(conds, " && ")
+}
+
+// ChildType is the type of the sqlc.embed_many struct
+func (g Group) ChildType() string {
+	return strings.TrimPrefix(g.Field.Type, "[]")
+}
+
+func embedManyVar(f Field) string {
+	return "null" + f.Name
+}
+
+// MapKey is the result column a :map query is keyed by
+type MapKey struct {
+	Type string
+	Expr string // the key of a scanned row
 }
 
 func (q Query) hasRetType() bool {
-	scanned := q.Cmd == metadata.CmdOne || q.Cmd == metadata.CmdMany ||
-		q.Cmd == metadata.CmdBatchMany || q.Cmd == metadata.CmdBatchOne
+	scanned := q.Cmd == metadata.CmdOne || q.Cmd == metadata.CmdOpt || q.Cmd == metadata.CmdMany ||
+		q.Cmd == metadata.CmdIter || q.Cmd == metadata.CmdMap || q.Cmd == metadata.CmdPaginate || q.Cmd == metadata.CmdBatchMany || q.Cmd == metadata.CmdBatchOne
 	return scanned && !q.Ret.isEmpty()
 }
 
@@ -0,0 +1,32 @@
+package golang
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/ZeyuRemtes/sqlc/internal/metadata"
+	"github.com/ZeyuRemtes/sqlc/internal/plugin"
+)
+
+// The clauses of a SELECT locking the rows it reads
+var lockingClause = regexp.MustCompile(`(?i)\bFOR\s+(NO\s+KEY\s+)?UPDATE\b|\bFOR\s+(KEY\s+)?SHARE\b|\bLOCK\s+IN\s+SHARE\s+MODE\b`)
+
+// onReplica reports whether a query can run on the read replica. Only SELECTs
+// returning rows qualify, unless they lock the rows they read or are sent to
+// the primary with the primary option. Statements starting with WITH are left
+// on the primary, as they can write.
+func onReplica(query *plugin.Query) bool {
+	switch query.Cmd {
+	case metadata.CmdOne, metadata.CmdOpt, metadata.CmdMany, metadata.CmdIter, metadata.CmdMap, metadata.CmdPaginate:
+	default:
+		return false
+	}
+	if primary, _ := metadata.Option(query.Options, "primary"); primary == "true" {
+		return false
+	}
+	fields := strings.Fields(query.Text)
+	if len(fields) == 0 || !strings.EqualFold(fields[0], "SELECT") {
+		return false
+	}
+	return !lockingClause.MatchString(query.Text)
+}
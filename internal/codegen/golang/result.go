@@ -97,6 +97,7 @@ func buildStructs(req *plugin.CodeGenRequest) []Struct {
 					Type:    goType(req, column),
 					Tags:    tags,
 					Comment: column.Comment,
+					Column:  column,
 				})
 			}
 			structs = append(structs, s)
@@ -118,6 +119,8 @@ type goEmbed struct {
 	modelType string
 	modelName string
 	fields    []string
+	types     []string
+	keys      []int
 }
 
 // look through all the structs and attempt to find a matching one to embed
@@ -139,14 +142,22 @@ func newGoEmbed(embed *plugin.Identifier, structs []Struct, defaultSchema string
 		}
 
 		fields := make([]string, len(s.Fields))
+		types := make([]string, len(s.Fields))
+		var keys []int
 		for i, f := range s.Fields {
 			fields[i] = f.Name
+			types[i] = f.Type
+			if f.Column.IsPrimaryKey {
+				keys = append(keys, i)
+			}
 		}
 
 		return &goEmbed{
 			modelType: s.Name,
 			modelName: s.Name,
 			fields:    fields,
+			types:     types,
+			keys:      keys,
 		}
 	}
 
@@ -307,17 +318,208 @@ func buildQueries(req *plugin.CodeGenRequest, structs []Struct) ([]Query, error)
 			}
 		}
 
+		if gq.Ret.Struct != nil {
+			group, err := embedManyGroup(req, query, gq.Ret, structs)
+			if err != nil {
+				return nil, err
+			}
+			gq.Group = group
+		}
+
+		opts, err := optionals(query.Text, req.Settings.Engine == "mysql")
+		if err != nil {
+			return nil, fmt.Errorf("query %q: %w", query.Name, err)
+		}
+		gq.Optionals = opts
+
+		order, err := sortClause(req, gq.MethodName, query.Text)
+		if err != nil {
+			return nil, fmt.Errorf("query %q: %w", query.Name, err)
+		}
+		gq.Sort = order
+
+		if query.Cmd == metadata.CmdPatch {
+			set, err := patchSet(query.Text)
+			if err != nil {
+				return nil, fmt.Errorf("query %q: %w", query.Name, err)
+			}
+			if gq.Arg.HasSqlcSlices() {
+				return nil, fmt.Errorf("query %q: :patch can't be used with sqlc.slice", query.Name)
+			}
+			gq.PatchSet = set
+			if gq.Arg.Struct != nil {
+				for i, f := range gq.Arg.Struct.Fields {
+					if f.Column.IsSqlcOptional {
+						gq.Arg.Struct.Fields[i].Type = patchType(f.Type)
+					}
+				}
+			} else {
+				gq.Arg.Typ = patchType(gq.Arg.Typ)
+			}
+		}
+
+		if query.Cmd == metadata.CmdCopyFrom && !sqlpkg.IsPGX() {
+			c, err := copyFrom(req, query, gq)
+			if err != nil {
+				return nil, fmt.Errorf("query %q: %w", query.Name, err)
+			}
+			columns := 1
+			if gq.Arg.Struct != nil {
+				columns = len(gq.Arg.Struct.Fields)
+			}
+			if c.Columns != columns {
+				return nil, fmt.Errorf("query %q: each parameter of a :copyfrom query must be used once", query.Name)
+			}
+			gq.CopyFrom = c
+		} else if query.Cmd == metadata.CmdCopyFrom && len(query.Options) > 0 {
+			return nil, fmt.Errorf("query %q: the tx and method options of :copyfrom are only supported by database/sql", query.Name)
+		}
+
+		if usesBatch([]Query{gq}) && !sqlpkg.IsPGX() {
+			if gq.Arg.HasSqlcSlices() || gq.Arg.HasSqlcOptionals() || gq.Sort != nil {
+				return nil, fmt.Errorf("query %q: :batch queries are prepared once and can't use sqlc.slice, sqlc.optional or sqlc.sort", query.Name)
+			}
+			if tx, _ := metadata.Option(query.Options, "tx"); tx == "true" {
+				gq.BatchTx = true
+			}
+		} else if usesBatch([]Query{gq}) && len(query.Options) > 0 {
+			return nil, fmt.Errorf("query %q: the tx option of :batch queries is only supported by database/sql", query.Name)
+		}
+
+		if query.Cmd == metadata.CmdPaginate {
+			if gq.Group != nil {
+				return nil, fmt.Errorf("query %q: :paginate can't be used with sqlc.embed_many", query.Name)
+			}
+			page, err := paginate(req, query, gq)
+			if err != nil {
+				return nil, fmt.Errorf("query %q: %w", query.Name, err)
+			}
+			gq.Paginate = page
+		}
+
+		if size, ok := metadata.Option(query.Options, "chunk"); ok {
+			if sqlpkg.IsPGX() {
+				return nil, fmt.Errorf("query %q: chunk is only supported by database/sql", query.Name)
+			}
+			if gq.Group != nil {
+				return nil, fmt.Errorf("query %q: chunk can't be used with sqlc.embed_many", query.Name)
+			}
+			c, err := chunk(gq, size, req.Settings.Go.EmitMethodsWithDbArgument)
+			if err != nil {
+				return nil, fmt.Errorf("query %q: %w", query.Name, err)
+			}
+			gq.Chunk = c
+		}
+
+		if req.Settings.Go.EmitReadReplica {
+			gq.Replica = onReplica(query)
+		}
+
+		if query.Cmd == metadata.CmdMap {
+			key, err := mapKey(req, query, gq.Ret)
+			if err != nil {
+				return nil, err
+			}
+			gq.MapKey = key
+		}
+
 		qs = append(qs, gq)
 	}
 	sort.Slice(qs, func(i, j int) bool { return qs[i].MethodName < qs[j].MethodName })
 	return qs, nil
 }
 
+// embedManyGroup returns how the rows of a query using sqlc.embed_many are
+// folded into one result per parent, which is identified by the primary keys
+// of the parent's tables.
+func embedManyGroup(req *plugin.CodeGenRequest, query *plugin.Query, ret QueryValue, structs []Struct) (*Group, error) {
+	var group *Group
+	var keys, types []string
+	for _, f := range ret.Struct.Fields {
+		switch {
+		case f.EmbedMany:
+			group = &Group{Field: f}
+		case len(f.EmbedFields) > 0:
+			for _, k := range f.EmbedKeys {
+				keys = append(keys, ret.Name+"."+f.Name+"."+f.EmbedFields[k])
+				types = append(types, f.EmbedTypes[k])
+			}
+		case isPrimaryKey(req, f.Column, structs):
+			keys = append(keys, ret.Name+"."+f.Name)
+			types = append(types, f.Type)
+		}
+	}
+	if group == nil {
+		return nil, nil
+	}
+	switch len(keys) {
+	case 0:
+		return nil, fmt.Errorf("query %q: sqlc.embed_many needs the primary key of the parent table in the result", query.Name)
+	case 1:
+		group.KeyType = types[0]
+		group.Key = keys[0]
+	default:
+		group.KeyType = fmt.Sprintf("[%d]interface{}", len(keys))
+		group.Key = group.KeyType + "{" + strings.Join(keys, ", ") + "}"
+	}
+	return group, nil
+}
+
+// isPrimaryKey reports whether a result column is part of the primary key of
+// the table it was selected from
+func isPrimaryKey(req *plugin.CodeGenRequest, c *plugin.Column, structs []Struct) bool {
+	name := c.OriginalName
+	if name == "" {
+		name = c.Name
+	}
+	for _, s := range structs {
+		if !sdk.SameTableName(c.Table, s.Table, req.Catalog.DefaultSchema) {
+			continue
+		}
+		for _, f := range s.Fields {
+			if f.Column.Name == name {
+				return f.Column.IsPrimaryKey
+			}
+		}
+	}
+	return false
+}
+
+// mapKey returns the key of the map a :map query returns
+func mapKey(req *plugin.CodeGenRequest, query *plugin.Query, ret QueryValue) (*MapKey, error) {
+	name, _ := metadata.Option(query.Options, "key")
+	for i, c := range query.Columns {
+		if c.Name != name || c.EmbedTable != nil {
+			continue
+		}
+		key := &MapKey{Type: goType(req, c), Expr: ret.Name}
+		if ret.Struct != nil {
+			key.Expr = ret.Name + "." + ret.Struct.Fields[i].Name
+		}
+		if !isComparable(key.Type) {
+			return nil, fmt.Errorf("query %q: key column %q has type %s, which can't be a map key", query.Name, name, key.Type)
+		}
+		return key, nil
+	}
+	return nil, fmt.Errorf("query %q: key column %q is not in the result", query.Name, name)
+}
+
+// isComparable reports whether values of a Go type can be compared, as map keys
+// must be. Slices, maps and functions can't.
+func isComparable(typ string) bool {
+	for _, prefix := range []string{"[]", "map[", "func("} {
+		if strings.HasPrefix(typ, prefix) {
+			return false
+		}
+	}
+	return typ != "json.RawMessage"
+}
+
 func putOutColumns(query *plugin.Query) bool {
 	if len(query.Columns) > 0 {
 		return true
 	}
-	for _, allowed := range []string{metadata.CmdMany, metadata.CmdOne, metadata.CmdBatchMany} {
+	for _, allowed := range []string{metadata.CmdMany, metadata.CmdIter, metadata.CmdMap, metadata.CmdPaginate, metadata.CmdOne, metadata.CmdOpt, metadata.CmdBatchMany} {
 		if query.Cmd == allowed {
 			return true
 		}
@@ -344,7 +546,7 @@ func columnsToStruct(req *plugin.CodeGenRequest, name string, columns []goColumn
 		tagName := colName
 
 		// override col/tag with expected model name
-		if c.embed != nil {
+		if c.embed != nil && !c.EmbedMany {
 			colName = c.embed.modelName
 			tagName = SetCaseStyle(colName, "snake")
 		}
@@ -383,6 +585,12 @@ func columnsToStruct(req *plugin.CodeGenRequest, name string, columns []goColumn
 		} else {
 			f.Type = c.embed.modelType
 			f.EmbedFields = c.embed.fields
+			f.EmbedTypes = c.embed.types
+			f.EmbedKeys = c.embed.keys
+		}
+		if c.embed != nil && c.EmbedMany {
+			f.Type = "[]" + c.embed.modelType
+			f.EmbedMany = true
 		}
 
 		gs.Fields = append(gs.Fields, f)
@@ -76,3 +76,26 @@ func TestPutOutColumns_AlwaysTrueWhenQueryHasColumns(t *testing.T) {
 		t.Error("should be true when we have columns")
 	}
 }
+
+func TestOptionals(t *testing.T) {
+	for _, test := range []struct {
+		sql       string
+		escapes   bool
+		predicate string
+	}{
+		{"WHERE (/*OPTIONAL:name*/ name = ?)", false, "(/*OPTIONAL:name*/ name = ?)"},
+		{"WHERE (/*OPTIONAL:name*/ name = concat('it''s )', ?))", false, "(/*OPTIONAL:name*/ name = concat('it''s )', ?))"},
+		{"WHERE (/*OPTIONAL:name*/ name = concat('C:\\', ?))", false, "(/*OPTIONAL:name*/ name = concat('C:\\', ?))"},
+		{"WHERE (/*OPTIONAL:name*/ name = concat('it\\'s )', ?))", true, "(/*OPTIONAL:name*/ name = concat('it\\'s )', ?))"},
+	} {
+		opts, err := optionals(test.sql, test.escapes)
+		if err != nil {
+			t.Errorf("%s: %s", test.sql, err)
+			continue
+		}
+		got := opts["name"]
+		if got.Predicate != test.predicate || got.Fallback != "(? IS NULL)" {
+			t.Errorf("%s: unexpected predicate %q with fallback %q", test.sql, got.Predicate, got.Fallback)
+		}
+	}
+}
@@ -0,0 +1,99 @@
+package golang
+
+import (
+	"strings"
+
+	"github.com/ZeyuRemtes/sqlc/internal/metadata"
+	"github.com/ZeyuRemtes/sqlc/internal/plugin"
+)
+
+// Signature describes the DAL method generated for a query. The Access types
+// of the engines sharing a Go package all implement the same DAL interface, so
+// they have to agree on it.
+type Signature struct {
+	Name    string
+	Cmd     string
+	Params  string // the parameters after ctx, with the fields of structs
+	Results string // the results, with the fields of structs
+}
+
+// Signatures returns the DAL methods generated for the queries of req
+func Signatures(req *plugin.CodeGenRequest) ([]Signature, error) {
+	structs := buildStructs(req)
+	queries, err := buildQueries(req, structs)
+	if err != nil {
+		return nil, err
+	}
+	sigs := make([]Signature, 0, len(queries))
+	for _, q := range queries {
+		sigs = append(sigs, q.signature())
+	}
+	return sigs, nil
+}
+
+func (q Query) signature() Signature {
+	s := Signature{Name: q.MethodName, Cmd: q.Cmd}
+	var params []string
+	if q.Sort != nil {
+		params = append(params, "sortBy "+q.Sort.Type, "sortDir SortDirection")
+	}
+	if !q.Arg.isEmpty() {
+		switch {
+		case q.Cmd == metadata.CmdCopyFrom || strings.HasPrefix(q.Cmd, ":batch"):
+			params = append(params, q.Arg.Name+" []"+describe(q.Arg))
+		case !q.Arg.EmitStruct() && q.Arg.IsStruct():
+			for _, f := range q.Arg.Struct.Fields {
+				params = append(params, toLowerCase(f.Name)+" "+f.Type)
+			}
+		default:
+			params = append(params, q.Arg.Name+" "+describe(q.Arg))
+		}
+	}
+
+	var ret string
+	if q.hasRetType() {
+		ret = describe(q.Ret)
+	}
+	switch q.Cmd {
+	case metadata.CmdOne:
+		s.Results = ret + ", error"
+	case metadata.CmdOpt:
+		s.Results = ret + ", bool, error"
+	case metadata.CmdMany:
+		s.Results = "[]" + ret + ", error"
+	case metadata.CmdIter:
+		params = append(params, "fn func("+ret+") error")
+		s.Results = "error"
+	case metadata.CmdMap:
+		s.Results = "map[" + q.MapKey.Type + "]" + ret + ", error"
+	case metadata.CmdPaginate:
+		params = append(params, "cursor "+q.Paginate.Cursor, "limit int")
+		s.Results = q.Paginate.Type + "{Items []" + ret + "}, error"
+	case metadata.CmdExec, metadata.CmdPatch:
+		s.Results = "error"
+	case metadata.CmdExecRows, metadata.CmdExecLastId, metadata.CmdCopyFrom:
+		s.Results = "int64, error"
+	case metadata.CmdExecResult:
+		s.Results = "sql.Result, error"
+	case metadata.CmdBatchExec:
+		s.Results = "*" + q.MethodName + "BatchResults"
+	case metadata.CmdBatchMany, metadata.CmdBatchOne:
+		s.Results = "*" + q.MethodName + "BatchResults{" + ret + "}"
+	}
+	s.Params = strings.Join(params, ", ")
+	return s
+}
+
+// describe spells out a Go type along with its fields when it's a struct,
+// since engines can generate structs of the same name with different fields
+func describe(v QueryValue) string {
+	t := v.DefineType()
+	if v.Struct == nil {
+		return t
+	}
+	fields := make([]string, 0, len(v.Struct.Fields))
+	for _, f := range v.Struct.Fields {
+		fields = append(fields, f.Name+" "+f.Type)
+	}
+	return t + "{" + strings.Join(fields, "; ") + "}"
+}
@@ -0,0 +1,65 @@
+package golang
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ZeyuRemtes/sqlc/internal/plugin"
+)
+
+// Sort is the sqlc.sort item of a query's ORDER BY clause. Generated code
+// swaps Placeholder for the column picked from an enum and a direction.
+type Sort struct {
+	Type        string // the enum of sortable columns
+	Placeholder string // the ORDER BY item written by the compiler
+	Columns     []SortColumn
+}
+
+type SortColumn struct {
+	Name  string // the enum constant
+	Value string // the column name
+	SQL   string // the column reference spliced into the query
+}
+
+// Default is the column used for values that aren't part of the enum
+func (s Sort) Default() SortColumn {
+	return s.Columns[0]
+}
+
+// sortClause finds the `/*SORT:a,b*/a ASC` placeholder the compiler left in
+// place of sqlc.sort
+func sortClause(req *plugin.CodeGenRequest, methodName, sql string) (*Sort, error) {
+	const marker = "/*SORT:"
+	start := strings.Index(sql, marker)
+	if start < 0 {
+		return nil, nil
+	}
+	end := strings.Index(sql[start:], "*/")
+	if end < 0 {
+		return nil, fmt.Errorf("malformed sqlc.sort clause")
+	}
+	refs := strings.Split(sql[start+len(marker):start+end], ",")
+	placeholder := sql[start:start+end+len("*/")] + refs[0] + " ASC"
+	if !strings.HasPrefix(sql[start:], placeholder) {
+		return nil, fmt.Errorf("malformed sqlc.sort clause")
+	}
+
+	s := &Sort{
+		Type:        methodName + "Sort",
+		Placeholder: placeholder,
+	}
+	seen := map[string]bool{}
+	for _, ref := range refs {
+		value := ref[strings.LastIndexByte(ref, '.')+1:]
+		if seen[value] {
+			return nil, fmt.Errorf("sqlc.sort lists column %q twice", value)
+		}
+		seen[value] = true
+		s.Columns = append(s.Columns, SortColumn{
+			Name:  s.Type + StructName(value, req.Settings),
+			Value: value,
+			SQL:   ref,
+		})
+	}
+	return s, nil
+}
@@ -0,0 +1,152 @@
+package compiler
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ZeyuRemtes/sqlc/internal/sql/ast"
+	"github.com/ZeyuRemtes/sqlc/internal/sql/astutils"
+)
+
+// validateChunk checks that a query with the chunk option returns the same
+// rows, or changes the same rows, when it runs once for each part of its
+// sqlc.slice. The slice has to filter rows on its own, and nothing can depend
+// on the other rows of the result.
+func validateChunk(name string, raw *ast.RawStmt, rawSQL string, params []Parameter) error {
+	slices := map[int]bool{}
+	for _, p := range params {
+		if p.Column != nil && p.Column.IsSqlcSlice {
+			slices[p.Number] = true
+		}
+	}
+	uses := astutils.Search(raw.Stmt, func(node ast.Node) bool {
+		ref, ok := node.(*ast.ParamRef)
+		return ok && slices[ref.Number]
+	})
+	if len(uses.Items) != 1 {
+		return fmt.Errorf("query %q: chunk requires a single sqlc.slice, used once", name)
+	}
+	ref := uses.Items[0].(*ast.ParamRef)
+
+	var where ast.Node
+	switch stmt := raw.Stmt.(type) {
+	case *ast.SelectStmt:
+		if stmt.Op != ast.None {
+			return fmt.Errorf("query %q: chunk can't be used with UNION, INTERSECT or EXCEPT", name)
+		}
+		// Not every engine keeps GROUP BY and DISTINCT in the statement, so
+		// they are looked for in the query text
+		group, _ := lastKeywords(rawSQL, "GROUP", "BY")
+		having, _ := lastKeywords(rawSQL, "HAVING")
+		distinct, _ := lastKeywords(rawSQL, "SELECT", "DISTINCT")
+		switch {
+		case group >= 0 || having >= 0:
+			return fmt.Errorf("query %q: chunk can't be used with GROUP BY or HAVING, each chunk would be grouped on its own", name)
+		case hasAggregate(stmt.TargetList):
+			return fmt.Errorf("query %q: chunk can't be used with aggregate or window functions, each chunk would be aggregated on its own", name)
+		case distinct >= 0:
+			return fmt.Errorf("query %q: chunk can't be used with SELECT DISTINCT, rows of different chunks can be the same", name)
+		}
+		where = stmt.WhereClause
+	case *ast.UpdateStmt:
+		where = stmt.WhereClause
+	case *ast.DeleteStmt:
+		where = stmt.WhereClause
+	default:
+		return fmt.Errorf("query %q: chunk requires a SELECT, UPDATE or DELETE statement", name)
+	}
+	if start, _ := lastKeywords(rawSQL, "ORDER", "BY"); start >= 0 {
+		return fmt.Errorf("query %q: chunk can't be used with ORDER BY, each chunk would be sorted on its own", name)
+	}
+	if start, _ := lastKeywords(rawSQL, "LIMIT"); start >= 0 {
+		return fmt.Errorf("query %q: chunk can't be used with LIMIT, each chunk would be limited on its own", name)
+	}
+	if !slicedTerm(where, ref) {
+		return fmt.Errorf("query %q: chunk requires the sqlc.slice to be used as `column IN (sqlc.slice(...))` in the WHERE clause, joined to the rest of it with AND", name)
+	}
+	return nil
+}
+
+// slicedTerm reports whether the WHERE clause is made of terms joined with AND,
+// one of which is `expr IN (ref)`. A row then matches a chunk only when its
+// value is part of it.
+func slicedTerm(where ast.Node, ref *ast.ParamRef) bool {
+	switch n := where.(type) {
+	case *ast.BoolExpr:
+		if n.Boolop != ast.BoolExprTypeAnd || n.Args == nil {
+			return false
+		}
+		for _, arg := range n.Args.Items {
+			if slicedTerm(arg, ref) {
+				return true
+			}
+		}
+	case *ast.In:
+		if n.Not || n.Sel != nil || len(n.List) != 1 {
+			return false
+		}
+		p, ok := n.List[0].(*ast.ParamRef)
+		return ok && p.Number == ref.Number
+	}
+	return false
+}
+
+// aggregates are the aggregate functions of MySQL and SQLite
+var aggregates = map[string]bool{
+	"avg":               true,
+	"bit_and":           true,
+	"bit_or":            true,
+	"bit_xor":           true,
+	"count":             true,
+	"group_concat":      true,
+	"json_arrayagg":     true,
+	"json_group_array":  true,
+	"json_group_object": true,
+	"json_objectagg":    true,
+	"max":               true,
+	"min":               true,
+	"std":               true,
+	"stddev":            true,
+	"stddev_pop":        true,
+	"stddev_samp":       true,
+	"string_agg":        true,
+	"sum":               true,
+	"total":             true,
+	"var_pop":           true,
+	"var_samp":          true,
+	"variance":          true,
+}
+
+// aggregateSearch looks for aggregate and window functions in a SELECT list,
+// leaving out subqueries, which are computed for each row
+type aggregateSearch struct {
+	found bool
+}
+
+func (s *aggregateSearch) Visit(node ast.Node) astutils.Visitor {
+	switch n := node.(type) {
+	case *ast.SubLink, *ast.SelectStmt:
+		return nil
+	case *ast.FuncCall:
+		if n.AggStar || n.AggDistinct || n.Over != nil {
+			s.found = true
+		}
+		if n.Func != nil && aggregates[strings.ToLower(n.Func.Name)] {
+			// SQLite's min and max are scalar functions with more than one argument
+			lower := strings.ToLower(n.Func.Name)
+			if (lower != "min" && lower != "max") || n.Args == nil || len(n.Args.Items) == 1 {
+				s.found = true
+			}
+		}
+	}
+	return s
+}
+
+func hasAggregate(targets *ast.List) bool {
+	if targets == nil {
+		return false
+	}
+	s := &aggregateSearch{}
+	astutils.Walk(s, targets)
+	return s.found
+}
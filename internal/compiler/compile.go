@@ -7,12 +7,19 @@ import (
 	"os"
 	"path/filepath"
 	"strings"
+	"time"
 
+	"github.com/ZeyuRemtes/sqlc/internal/cache"
+	"github.com/ZeyuRemtes/sqlc/internal/config"
+	"github.com/ZeyuRemtes/sqlc/internal/debug"
+	"github.com/ZeyuRemtes/sqlc/internal/info"
 	"github.com/ZeyuRemtes/sqlc/internal/metadata"
 	"github.com/ZeyuRemtes/sqlc/internal/migrations"
 	"github.com/ZeyuRemtes/sqlc/internal/multierr"
 	"github.com/ZeyuRemtes/sqlc/internal/opts"
+	"github.com/ZeyuRemtes/sqlc/internal/source"
 	"github.com/ZeyuRemtes/sqlc/internal/sql/ast"
+	"github.com/ZeyuRemtes/sqlc/internal/sql/catalog"
 	"github.com/ZeyuRemtes/sqlc/internal/sql/sqlerr"
 	"github.com/ZeyuRemtes/sqlc/internal/sql/sqlpath"
 )
@@ -25,27 +32,43 @@ type Parser interface {
 }
 
 // end copypasta
-func (c *Compiler) parseCatalog(schemas []string) error {
+func (c *Compiler) parseCatalog(schemas []string, o opts.Parser) error {
 	files, err := sqlpath.Glob(schemas)
 	if err != nil {
 		return err
 	}
+	files, err = migrations.Order(c.conf.Migrations, files)
+	if err != nil {
+		return err
+	}
 	merr := multierr.New()
-	for _, filename := range files {
-		blob, err := os.ReadFile(filename)
+	contents := make([][]byte, len(files))
+	for i, filename := range files {
+		contents[i], err = os.ReadFile(filename)
 		if err != nil {
 			merr.Add(filename, "", 0, err)
-			continue
 		}
-		contents := migrations.RemoveRollbackStatements(string(blob))
-		stmts, err := c.parser.Parse(strings.NewReader(contents))
-		if err != nil {
-			merr.Add(filename, contents, 0, err)
-			continue
+	}
+	if len(merr.Errs()) > 0 {
+		return merr
+	}
+
+	var key string
+	if o.Debug.CatalogCache && !c.introspected {
+		key = cache.CatalogKey(string(c.conf.Engine), files, contents)
+		if snap, ok := cache.LoadCatalog(key); ok {
+			snap.Catalog.LoadExtension = c.catalog.LoadExtension
+			c.catalog = snap.Catalog
+			return c.addFunctions(c.conf.Functions)
 		}
+	}
+
+	for i, filename := range files {
+		src := migrations.RemoveRollbackStatements(string(contents[i]))
+		stmts := c.parseFile(filename, src, merr)
 		for i := range stmts {
 			if err := c.catalog.Update(stmts[i], c); err != nil {
-				merr.Add(filename, contents, stmts[i].Pos(), err)
+				merr.Add(filename, src, stmts[i].Pos(), err)
 				continue
 			}
 		}
@@ -53,12 +76,54 @@ func (c *Compiler) parseCatalog(schemas []string) error {
 	if len(merr.Errs()) > 0 {
 		return merr
 	}
+
+	if key != "" {
+		// A snapshot that can't be written only makes the next run slower, so
+		// the failure is only reported when debugging
+		err := cache.StoreCatalog(key, &cache.Snapshot{
+			Engine:  string(c.conf.Engine),
+			Version: info.Version,
+			Schema:  files,
+			Created: time.Now(),
+			Catalog: c.catalog,
+		})
+		if err != nil && debug.Active {
+			fmt.Fprintf(os.Stderr, "failed to store catalog snapshot: %s\n", err)
+		}
+	}
+	return c.addFunctions(c.conf.Functions)
+}
+
+// addFunctions adds the functions declared in the configuration file to the
+// catalog, replacing any built-in function with the same signature.
+func (c *Compiler) addFunctions(fns []config.Function) error {
+	for _, f := range fns {
+		name := strings.Split(strings.ToLower(f.Name), ".")
+		var schema string
+		if len(name) > 1 {
+			schema = name[0]
+		}
+		fn := &catalog.Function{
+			Name:               name[len(name)-1],
+			ReturnType:         &ast.TypeName{Name: strings.ToLower(f.Returns)},
+			ReturnTypeNullable: f.Nullable,
+		}
+		for _, arg := range f.Args {
+			fn.Args = append(fn.Args, &catalog.Argument{
+				Type: &ast.TypeName{Name: strings.ToLower(arg)},
+			})
+		}
+		if err := c.catalog.AddFunction(schema, fn); err != nil {
+			return fmt.Errorf("function %s: %w", f.Name, err)
+		}
+	}
 	return nil
 }
 
 func (c *Compiler) parseQueries(o opts.Parser) (*Result, error) {
 	var q []*Query
 	merr := multierr.New()
+	warns := multierr.New()
 	set := map[string]struct{}{}
 	files, err := sqlpath.Glob(c.conf.Queries)
 	if err != nil {
@@ -70,12 +135,12 @@ func (c *Compiler) parseQueries(o opts.Parser) (*Result, error) {
 			merr.Add(filename, "", 0, err)
 			continue
 		}
-		src := string(blob)
-		stmts, err := c.parser.Parse(strings.NewReader(src))
+		src, pos, err := engineVariants(c.conf.Engine, string(blob))
 		if err != nil {
-			merr.Add(filename, src, 0, err)
+			merr.Add(filename, string(blob), pos, err)
 			continue
 		}
+		stmts := c.parseFile(filename, src, merr)
 		for _, stmt := range stmts {
 			query, err := c.parseQuery(stmt.Raw, src, o)
 			if err == ErrUnsupportedStatementType {
@@ -98,6 +163,14 @@ func (c *Compiler) parseQueries(o opts.Parser) (*Result, error) {
 				set[query.Name] = struct{}{}
 			}
 			query.Filename = filepath.Base(filename)
+			query.Path = filename
+			query.Line, query.Column = 1, 1
+			if stmt.Raw.Pos() != 0 {
+				query.Line, query.Column = source.LineNumber(src, stmt.Raw.Pos())
+			}
+			for _, w := range query.warnings {
+				warns.Add(filename, src, stmt.Raw.Pos(), w)
+			}
 			if query != nil {
 				q = append(q, query)
 			}
@@ -110,7 +183,54 @@ func (c *Compiler) parseQueries(o opts.Parser) (*Result, error) {
 		return nil, fmt.Errorf("no queries contained in paths %s", strings.Join(c.conf.Queries, ","))
 	}
 	return &Result{
-		Catalog: c.catalog,
-		Queries: q,
+		Catalog:  c.catalog,
+		Queries:  q,
+		Warnings: warns.Errs(),
 	}, nil
 }
+
+// parseFile parses every statement in src. If the file contains syntax
+// errors, it is split into statements which are parsed one at a time, so that
+// each broken statement is reported with its own location and the remaining
+// statements are still returned.
+func (c *Compiler) parseFile(filename, src string, merr *multierr.Error) []ast.Statement {
+	stmts, err := c.parser.Parse(strings.NewReader(src))
+	if err == nil {
+		return stmts
+	}
+	stmts = nil
+	failed := false
+	for _, span := range source.Split(src, c.parser.CommentSyntax().Hash) {
+		// Blank out the other statements so that the locations reported by
+		// the parser are relative to the start of the file
+		padded := source.Blank(src[:span.Start]) + src[span.Start:span.End] + source.Blank(src[span.End:])
+		out, err := c.parser.Parse(strings.NewReader(padded))
+		if err != nil {
+			failed = true
+			merr.Add(filename, src, syntaxErrorLocation(err, span.Start), err)
+			continue
+		}
+		for _, stmt := range out {
+			if stmt.Raw.StmtLocation < span.Start {
+				stmt.Raw.StmtLen -= span.Start - stmt.Raw.StmtLocation
+				stmt.Raw.StmtLocation = span.Start
+			}
+			stmts = append(stmts, stmt)
+		}
+	}
+	if !failed {
+		// The file doesn't parse as a whole, but each statement does
+		merr.Add(filename, src, 0, err)
+	}
+	return stmts
+}
+
+// syntaxErrorLocation returns the location to report a statement's syntax
+// error at. Errors that carry their own line and column are left alone.
+func syntaxErrorLocation(err error, start int) int {
+	var serr *sqlerr.Error
+	if errors.As(err, &serr) && (serr.Location != 0 || serr.Line != 0) {
+		return 0
+	}
+	return start
+}
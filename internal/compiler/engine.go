@@ -1,11 +1,13 @@
 package compiler
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/ZeyuRemtes/sqlc/internal/config"
 	"github.com/ZeyuRemtes/sqlc/internal/engine/dolphin"
 	"github.com/ZeyuRemtes/sqlc/internal/engine/sqlite"
+	"github.com/ZeyuRemtes/sqlc/internal/introspect"
 	"github.com/ZeyuRemtes/sqlc/internal/opts"
 	"github.com/ZeyuRemtes/sqlc/internal/sql/catalog"
 )
@@ -16,6 +18,10 @@ type Compiler struct {
 	catalog *catalog.Catalog
 	parser  Parser
 	result  *Result
+
+	// Set once tables from a live database have been added to the catalog,
+	// which then can't be cached by schema contents alone
+	introspected bool
 }
 
 func NewCompiler(conf config.SQL, combo config.CombinedSettings) *Compiler {
@@ -40,8 +46,25 @@ func (c *Compiler) Catalog() *catalog.Catalog {
 	return c.catalog
 }
 
-func (c *Compiler) ParseCatalog(schema []string) error {
-	return c.parseCatalog(schema)
+// IntrospectCatalog adds the tables and views of the database at url to the
+// catalog. Call it before ParseCatalog so that schema files can build on the
+// introspected tables.
+func (c *Compiler) IntrospectCatalog(ctx context.Context, url string) error {
+	tables, err := introspect.Load(ctx, c.conf.Engine, url)
+	if err != nil {
+		return err
+	}
+	for _, stmt := range introspect.Statements(tables) {
+		if err := c.catalog.Update(stmt, c); err != nil {
+			return err
+		}
+	}
+	c.introspected = true
+	return nil
+}
+
+func (c *Compiler) ParseCatalog(schema []string, o opts.Parser) error {
+	return c.parseCatalog(schema, o)
 }
 
 func (c *Compiler) ParseQueries(queries []string, o opts.Parser) error {
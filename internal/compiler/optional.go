@@ -0,0 +1,90 @@
+package compiler
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ZeyuRemtes/sqlc/internal/source"
+	"github.com/ZeyuRemtes/sqlc/internal/sql/ast"
+	"github.com/ZeyuRemtes/sqlc/internal/sql/astutils"
+	"github.com/ZeyuRemtes/sqlc/internal/sql/rewrite"
+	"github.com/ZeyuRemtes/sqlc/internal/sql/sqlerr"
+)
+
+// optionalEdits marks the parameter of each sqlc.optional predicate and
+// replaces the call with a parenthesized predicate that starts with an
+// `/*OPTIONAL:name*/` comment. Generated code looks for the comment to drop
+// the predicate when the parameter is nil.
+func optionalEdits(raw *ast.RawStmt, rawSQL string, optionals []*rewrite.Optional, params []Parameter) ([]source.Edit, error) {
+	var edits []source.Edit
+	for _, opt := range optionals {
+		refs := astutils.Search(opt.Node, func(node ast.Node) bool {
+			_, ok := node.(*ast.ParamRef)
+			return ok
+		})
+		if len(refs.Items) != 1 {
+			return nil, &sqlerr.Error{
+				Message:  fmt.Sprintf("sqlc.optional must contain exactly one parameter; got %d", len(refs.Items)),
+				Location: opt.Location,
+			}
+		}
+		number := refs.Items[0].(*ast.ParamRef).Number
+
+		uses := len(astutils.Search(raw.Stmt, func(node ast.Node) bool {
+			ref, ok := node.(*ast.ParamRef)
+			return ok && ref.Number == number
+		}).Items)
+		var param *Parameter
+		for i := range params {
+			if params[i].Number == number {
+				param = &params[i]
+			}
+		}
+		if param == nil || param.Column == nil {
+			return nil, &sqlerr.Error{
+				Message:  "sqlc.optional parameter could not be resolved",
+				Location: opt.Location,
+			}
+		}
+		col := param.Column
+		if col.Name == "" {
+			return nil, &sqlerr.Error{
+				Message:  "sqlc.optional parameter needs a name; use sqlc.arg",
+				Location: opt.Location,
+			}
+		}
+		if col.IsSqlcSlice {
+			return nil, &sqlerr.Error{
+				Message:  "sqlc.optional can't be used with sqlc.slice",
+				Location: opt.Location,
+			}
+		}
+		for _, p := range params {
+			if p.Number != number && p.Column != nil && p.Column.Name == col.Name {
+				uses++
+			}
+		}
+		if uses > 1 {
+			return nil, &sqlerr.Error{
+				Message:  fmt.Sprintf("parameter %q of sqlc.optional can't be used elsewhere in the query", col.Name),
+				Location: opt.Location,
+			}
+		}
+		col.IsSqlcOptional = true
+
+		start := opt.Location - raw.StmtLocation
+		open := strings.IndexByte(rawSQL[start:], '(')
+		if open < 0 {
+			return nil, &sqlerr.Error{
+				Message:  "sqlc.optional is missing its opening parenthesis",
+				Location: opt.Location,
+			}
+		}
+		edits = append(edits, source.Edit{
+			Location: start,
+			Old:      rawSQL[start : start+open+1],
+			New:      fmt.Sprintf("(/*OPTIONAL:%s*/", col.Name),
+		})
+	}
+	return edits, nil
+}
@@ -262,6 +262,7 @@ func (c *Compiler) outputColumns(qc *QueryCatalog, node ast.Node) ([]*Column, er
 					cols = append(cols, &Column{
 						Name:       embed.Table.Name,
 						EmbedTable: embed.Table,
+						EmbedMany:  embed.Many,
 					})
 					continue
 				}
@@ -540,6 +541,10 @@ func (c *Compiler) sourceTables(qc *QueryCatalog, node ast.Node) ([]*Table, erro
 				table.Rel = &ast.TableName{
 					Name: *n.Alias.Aliasname,
 				}
+			} else {
+				table.Rel = &ast.TableName{
+					Name: funcCall.Func.Name,
+				}
 			}
 			tables = append(tables, table)
 
@@ -620,6 +625,7 @@ func outputColumnRefs(res *ast.ResTarget, tables []*Table, node *ast.ColumnRef)
 					IsArray:      c.IsArray,
 					Length:       c.Length,
 					EmbedTable:   c.EmbedTable,
+					EmbedMany:    c.EmbedMany,
 					OriginalName: c.Name,
 				})
 			}
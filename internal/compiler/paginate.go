@@ -0,0 +1,304 @@
+package compiler
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ZeyuRemtes/sqlc/internal/source"
+	"github.com/ZeyuRemtes/sqlc/internal/sql/ast"
+	"github.com/ZeyuRemtes/sqlc/internal/sql/astutils"
+	"github.com/ZeyuRemtes/sqlc/internal/sql/catalog"
+)
+
+// cursorColumn is an ORDER BY column of a :paginate query
+type cursorColumn struct {
+	ref    string // the column in the WHERE clause
+	desc   bool
+	output int // the result column holding its value
+}
+
+// paginateEdits checks that the ORDER BY clause of a :paginate query sorts by
+// a unique key made of result columns, and leaves an
+// `/*PAGINATE:AND:ref DESC 2,...*/` comment where the WHERE clause ends.
+// Generated code turns the comment into a predicate that only matches rows
+// after the cursor.
+func (c *Compiler) paginateEdits(name string, qc *QueryCatalog, raw *ast.RawStmt, rawSQL string, cols []*Column) ([]source.Edit, error) {
+	sel := raw.Stmt.(*ast.SelectStmt)
+	if len(astutils.Search(sel, isSort).Items) > 0 {
+		return nil, fmt.Errorf("query %q: :paginate can't be used with sqlc.sort", name)
+	}
+	var refs []*ast.ColumnRef
+	if sel.WindowClause != nil {
+		for _, clause := range sel.WindowClause.Items {
+			list, ok := clause.(*ast.List)
+			if !ok {
+				continue
+			}
+			for _, item := range list.Items {
+				expr, ok := item.(*ast.CaseExpr)
+				if !ok {
+					return nil, fmt.Errorf("query %q: the ORDER BY clause of a :paginate query can only list columns", name)
+				}
+				ref, ok := expr.Xpr.(*ast.ColumnRef)
+				if !ok {
+					return nil, fmt.Errorf("query %q: the ORDER BY clause of a :paginate query can only list columns", name)
+				}
+				refs = append(refs, ref)
+			}
+		}
+	}
+	orderStart, orderEnd := lastKeywords(rawSQL, "ORDER", "BY")
+	if len(refs) == 0 || orderStart < 0 {
+		return nil, fmt.Errorf("query %q: :paginate requires an ORDER BY clause", name)
+	}
+	items := strings.Split(strings.TrimRight(rawSQL[orderEnd:], "; \t\r\n"), ",")
+	if len(items) != len(refs) {
+		return nil, fmt.Errorf("query %q: the ORDER BY clause of a :paginate query can only list columns", name)
+	}
+
+	tables, err := c.sourceTables(qc, sel)
+	if err != nil {
+		return nil, err
+	}
+	var cursor []cursorColumn
+	for i, ref := range refs {
+		words := strings.Fields(items[i])
+		var desc bool
+		switch {
+		case len(words) == 1:
+		case len(words) == 2 && strings.EqualFold(words[1], "ASC"):
+		case len(words) == 2 && strings.EqualFold(words[1], "DESC"):
+			desc = true
+		default:
+			return nil, fmt.Errorf("query %q: the ORDER BY clause of a :paginate query can only list columns, followed by ASC or DESC", name)
+		}
+		col, err := cursorColumnOf(name, ref, tables, cols)
+		if err != nil {
+			return nil, err
+		}
+		col.desc = desc
+		cursor = append(cursor, col)
+	}
+	if !c.uniqueKey(tables, cursor, cols) {
+		return nil, fmt.Errorf("query %q: the ORDER BY clause of a :paginate query must include the primary key or a unique index of every table", name)
+	}
+
+	var entries []string
+	for _, col := range cursor {
+		dir := "ASC"
+		if col.desc {
+			dir = "DESC"
+		}
+		entries = append(entries, fmt.Sprintf("%s %s %d", col.ref, dir, col.output))
+	}
+	connector := "WHERE"
+	if sel.WhereClause != nil {
+		connector = "AND"
+	}
+	end := strings.LastIndexFunc(rawSQL[:orderStart], func(r rune) bool { return !isSpace(byte(r)) }) + 1
+	edit := source.Edit{
+		Location: end,
+		New:      fmt.Sprintf(" /*PAGINATE:%s:%s*/", connector, strings.Join(entries, ",")),
+	}
+
+	// The predicate is joined with AND, so a WHERE clause made of terms
+	// joined with OR has to be wrapped in parentheses
+	if expr, ok := sel.WhereClause.(*ast.BoolExpr); ok && expr.Boolop == ast.BoolExprTypeOr {
+		_, whereEnd := lastKeywords(rawSQL[:orderStart], "WHERE")
+		if whereEnd < 0 {
+			return nil, fmt.Errorf("query %q: can't find the WHERE clause", name)
+		}
+		for whereEnd < len(rawSQL) && isSpace(rawSQL[whereEnd]) {
+			whereEnd++
+		}
+		edit.New = ")" + edit.New
+		return []source.Edit{{Location: whereEnd, New: "("}, edit}, nil
+	}
+	return []source.Edit{edit}, nil
+}
+
+// cursorColumnOf finds the result column an ORDER BY column refers to. The
+// cursor stores its value, so it must be part of the result and can't be NULL.
+func cursorColumnOf(name string, ref *ast.ColumnRef, tables []*Table, cols []*Column) (cursorColumn, error) {
+	parts := stringSlice(ref.Fields)
+	text := strings.Join(parts, ".")
+	found := -1
+	for i, col := range cols {
+		if col.Table == nil || col.EmbedTable != nil {
+			continue
+		}
+		var match bool
+		switch len(parts) {
+		case 1:
+			match = col.Name == parts[0]
+		case 2:
+			for _, t := range tables {
+				if t.Rel.Name != parts[0] || t.Rel.Name != col.TableAlias && col.TableAlias != "" {
+					continue
+				}
+				for _, tc := range t.Columns {
+					if tc.Name == parts[1] && tc.Name == col.OriginalName && sameTable(tc.Table, col.Table) {
+						match = true
+					}
+				}
+			}
+		}
+		if !match {
+			continue
+		}
+		if found >= 0 {
+			return cursorColumn{}, fmt.Errorf("query %q: ORDER BY column %q matches more than one result column", name, text)
+		}
+		found = i
+	}
+	if found < 0 {
+		return cursorColumn{}, fmt.Errorf("query %q: ORDER BY column %q of a :paginate query must be a table column of the result", name, text)
+	}
+	col := cols[found]
+	if !col.NotNull {
+		return cursorColumn{}, fmt.Errorf("query %q: ORDER BY column %q of a :paginate query can't be NULL", name, text)
+	}
+	out := cursorColumn{ref: text, output: found}
+	if len(parts) == 1 && col.OriginalName != "" && col.OriginalName != col.Name {
+		// WHERE can't use the aliases of the result columns
+		out.ref = col.OriginalName
+		if col.TableAlias != "" {
+			out.ref = col.TableAlias + "." + col.OriginalName
+		}
+	}
+	return out, nil
+}
+
+// uniqueKey reports whether the cursor columns include the primary key or a
+// unique index of every table in the FROM clause. A join repeats the rows of a
+// table, so the key of one table doesn't identify a row of the result.
+func (c *Compiler) uniqueKey(tables []*Table, cursor []cursorColumn, cols []*Column) bool {
+	for _, t := range tables {
+		if len(t.Columns) == 0 || t.Columns[0].Table == nil {
+			return false
+		}
+		rel := t.Columns[0].Table
+		table, err := c.catalog.GetTable(rel)
+		if err != nil {
+			return false
+		}
+		names := map[string]bool{}
+		for _, cc := range cursor {
+			col := cols[cc.output]
+			if sameTable(col.Table, rel) && (col.TableAlias == "" || col.TableAlias == t.Rel.Name) {
+				names[col.OriginalName] = true
+			}
+		}
+		if !hasKey(table, names) {
+			return false
+		}
+	}
+	return len(tables) > 0
+}
+
+func hasKey(table catalog.Table, names map[string]bool) bool {
+	var pk []string
+	for _, col := range table.Columns {
+		if col.IsPrimaryKey {
+			pk = append(pk, col.Name)
+		}
+	}
+	keys := [][]string{pk}
+	for _, idx := range table.Indexes {
+		if idx.Unique {
+			keys = append(keys, idx.Columns)
+		}
+	}
+	for _, key := range keys {
+		covered := len(key) > 0
+		for _, name := range key {
+			covered = covered && names[name]
+		}
+		if covered {
+			return true
+		}
+	}
+	return false
+}
+
+func sameTable(a, b *ast.TableName) bool {
+	return a != nil && b != nil && a.Schema == b.Schema && a.Name == b.Name
+}
+
+// lastKeywords returns the start and end of the last sequence of keywords
+// outside of parentheses, quotes and comments, or -1, -1
+func lastKeywords(sql string, words ...string) (int, int) {
+	start, end := -1, -1
+	var depth int
+	for i := 0; i < len(sql); i++ {
+		switch c := sql[i]; c {
+		case '\'', '"', '`':
+			j := strings.IndexByte(sql[i+1:], c)
+			if j < 0 {
+				return start, end
+			}
+			i += j + 1
+			continue
+		case '/':
+			if strings.HasPrefix(sql[i:], "/*") {
+				j := strings.Index(sql[i:], "*/")
+				if j < 0 {
+					return start, end
+				}
+				i += j + 1
+			}
+			continue
+		case '-':
+			if strings.HasPrefix(sql[i:], "--") {
+				j := strings.IndexByte(sql[i:], '\n')
+				if j < 0 {
+					return start, end
+				}
+				i += j
+			}
+			continue
+		case '(':
+			depth++
+			continue
+		case ')':
+			depth--
+			continue
+		}
+		if depth != 0 || i > 0 && isIdentByte(sql[i-1]) {
+			continue
+		}
+		if stop := matchKeywords(sql[i:], words); stop > 0 {
+			start, end = i, i+stop
+		}
+	}
+	return start, end
+}
+
+// matchKeywords returns the length of the keywords at the start of sql,
+// separated by whitespace, or 0
+func matchKeywords(sql string, words []string) int {
+	var n int
+	for i, word := range words {
+		if i > 0 {
+			spaces := n
+			for n < len(sql) && isSpace(sql[n]) {
+				n++
+			}
+			if n == spaces {
+				return 0
+			}
+		}
+		if len(sql) < n+len(word) || !strings.EqualFold(sql[n:n+len(word)], word) {
+			return 0
+		}
+		n += len(word)
+		if n < len(sql) && isIdentByte(sql[n]) {
+			return 0
+		}
+	}
+	return n
+}
+
+func isIdentByte(c byte) bool {
+	return c == '_' || c == '$' || '0' <= c && c <= '9' || 'a' <= c && c <= 'z' || 'A' <= c && c <= 'Z'
+}
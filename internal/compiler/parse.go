@@ -70,14 +70,21 @@ func (c *Compiler) parseQuery(stmt ast.Node, src string, o opts.Parser) (*Query,
 	if err := validate.In(c.catalog, raw); err != nil {
 		return nil, err
 	}
-	name, cmd, err := metadata.Parse(strings.TrimSpace(rawSQL), c.parser.CommentSyntax())
+	name, cmd, options, err := metadata.Parse(strings.TrimSpace(rawSQL), c.parser.CommentSyntax())
 	if err != nil {
 		return nil, err
 	}
+	warns := namedParamWarnings(raw)
 	raw, namedParams, edits := rewrite.NamedParameters(c.conf.Engine, raw, numbers, dollar)
 	if err := validate.Cmd(raw.Stmt, name, cmd); err != nil {
 		return nil, err
 	}
+	if err := validate.Optional(raw.Stmt); err != nil {
+		return nil, err
+	}
+	raw, optionals := rewrite.Optionals(raw)
+	warns = append(warns, execReturningWarnings(raw.Stmt, name, cmd)...)
+	warns = append(warns, unusedCTEWarnings(raw.Stmt)...)
 	rvs := rangeVars(raw.Stmt)
 	refs, err := findParameters(raw.Stmt)
 	if err != nil {
@@ -99,16 +106,56 @@ func (c *Compiler) parseQuery(stmt ast.Node, src string, o opts.Parser) (*Query,
 	if err != nil {
 		return nil, err
 	}
+	optEdits, err := optionalEdits(raw, rawSQL, optionals, params)
+	if err != nil {
+		return nil, err
+	}
+	edits = append(edits, optEdits...)
+	sortEdits, err := c.sortEdits(qc, raw, rawSQL)
+	if err != nil {
+		return nil, err
+	}
+	edits = append(edits, sortEdits...)
+	if cmd == metadata.CmdPatch {
+		edits, err = patchEdits(name, raw, rawSQL, edits, refs, params)
+		if err != nil {
+			return nil, err
+		}
+	}
 	cols, err := c.outputColumns(qc, raw.Stmt)
 	if err != nil {
 		return nil, err
 	}
+	if cmd == metadata.CmdMap {
+		if err := validateMapKey(name, cols, options); err != nil {
+			return nil, err
+		}
+	}
+	if err := validateEmbedMany(name, cmd, cols); err != nil {
+		return nil, err
+	}
+	if _, ok := metadata.Option(options, "chunk"); ok {
+		if err := validateChunk(name, raw, rawSQL, params); err != nil {
+			return nil, err
+		}
+	}
+	if cmd == metadata.CmdPaginate {
+		pageEdits, err := c.paginateEdits(name, qc, raw, rawSQL, cols)
+		if err != nil {
+			return nil, err
+		}
+		edits = append(edits, pageEdits...)
+	}
 
 	expandEdits, err := c.expand(qc, raw)
 	if err != nil {
 		return nil, err
 	}
 	edits = append(edits, expandEdits...)
+	if c.conf.WarnStarExpansion {
+		warns = append(warns, starExpansionWarnings(raw, expandEdits)...)
+	}
+	warns = append(warns, c.overrideWarnings(raw.StmtLocation, cols)...)
 	expanded, err := source.Mutate(rawSQL, edits)
 	if err != nil {
 		return nil, err
@@ -125,18 +172,64 @@ func (c *Compiler) parseQuery(stmt ast.Node, src string, o opts.Parser) (*Query,
 	if err != nil {
 		return nil, err
 	}
+	sort.SliceStable(warns, func(i, j int) bool {
+		return warns[i].Location < warns[j].Location
+	})
 	return &Query{
 		RawStmt:         raw,
 		Cmd:             cmd,
 		Comments:        comments,
 		Name:            name,
+		Options:         options,
 		Params:          params,
 		Columns:         cols,
 		SQL:             trimmed,
 		InsertIntoTable: table,
+		warnings:        warns,
 	}, nil
 }
 
+// validateMapKey checks that the key of a :map query is a result column that
+// can't be NULL
+func validateMapKey(name string, cols []*Column, options []string) error {
+	key, _ := metadata.Option(options, "key")
+	for _, col := range cols {
+		if col.Name != key || col.EmbedTable != nil {
+			continue
+		}
+		if !col.NotNull {
+			return fmt.Errorf("query %q: key column %q can be NULL", name, key)
+		}
+		return nil
+	}
+	return fmt.Errorf("query %q: key column %q is not in the result", name, key)
+}
+
+// validateEmbedMany checks that sqlc.embed_many is used at most once, in a
+// :many query. Rows are grouped in Go, so there must be a single list of
+// children per parent.
+func validateEmbedMany(name, cmd string, cols []*Column) error {
+	var many int
+	for _, col := range cols {
+		if col.EmbedMany {
+			many++
+		}
+	}
+	if many == 0 {
+		return nil
+	}
+	if cmd != metadata.CmdMany {
+		return fmt.Errorf("query %q: sqlc.embed_many can only be used in :many queries", name)
+	}
+	if many > 1 {
+		return fmt.Errorf("query %q: sqlc.embed_many can only be used once per query", name)
+	}
+	if many == len(cols) {
+		return fmt.Errorf("query %q: sqlc.embed_many needs parent columns to group by", name)
+	}
+	return nil
+}
+
 func rangeVars(root ast.Node) []*ast.RangeVar {
 	var vars []*ast.RangeVar
 	find := astutils.VisitorFunc(func(node ast.Node) {
@@ -0,0 +1,127 @@
+package compiler
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/ZeyuRemtes/sqlc/internal/source"
+	"github.com/ZeyuRemtes/sqlc/internal/sql/ast"
+	"github.com/ZeyuRemtes/sqlc/internal/sql/astutils"
+)
+
+// patchEdits prepares the SET list of a :patch query. Every column set to a
+// parameter is marked with an `/*PATCH:name*/` comment, and generated code
+// only writes the marked columns whose parameter is set. Dropping an
+// assignment drops its argument too, so all parameters must be positional and
+// used once, in order.
+func patchEdits(name string, raw *ast.RawStmt, rawSQL string, edits []source.Edit, refs []paramRef, params []Parameter) ([]source.Edit, error) {
+	numbered := astutils.Search(raw.Stmt, func(node ast.Node) bool {
+		ref, ok := node.(*ast.ParamRef)
+		return ok && ref.Dollar
+	})
+	if len(numbered.Items) > 0 {
+		return nil, fmt.Errorf("query %q: :patch doesn't support numbered parameters", name)
+	}
+	byLocation := make([]paramRef, len(refs))
+	copy(byLocation, refs)
+	sort.Slice(byLocation, func(i, j int) bool { return byLocation[i].ref.Location < byLocation[j].ref.Location })
+	names := map[string]bool{}
+	var named int
+	for _, p := range params {
+		if p.Column != nil && p.Column.Name != "" {
+			names[p.Column.Name] = true
+			named++
+		}
+	}
+	for i, ref := range byLocation {
+		uses := astutils.Search(raw.Stmt, func(node ast.Node) bool {
+			r, ok := node.(*ast.ParamRef)
+			return ok && r.Number == ref.ref.Number
+		})
+		if ref.ref.Number != i+1 || len(uses.Items) != 1 || len(names) < named {
+			return nil, fmt.Errorf("query %q: each parameter of a :patch query must be used once, and ? can't be mixed with named parameters", name)
+		}
+	}
+
+	// SQLite numbers named parameters, which would no longer match once an
+	// assignment is dropped
+	for i := range edits {
+		if isNumberedParam(edits[i].New) {
+			edits[i].New = "?"
+		}
+	}
+
+	stmt := raw.Stmt.(*ast.UpdateStmt)
+	last := -1
+	for i, item := range stmt.TargetList.Items {
+		res, ok := item.(*ast.ResTarget)
+		if !ok {
+			continue
+		}
+		ref, ok := res.Val.(*ast.ParamRef)
+		if !ok {
+			continue
+		}
+		if last >= 0 && last != i-1 {
+			return nil, fmt.Errorf("query %q: the columns set to parameters in a :patch query must be next to each other", name)
+		}
+		last = i
+
+		var col *Column
+		for _, p := range params {
+			if p.Number == ref.Number {
+				col = p.Column
+			}
+		}
+		if col == nil {
+			return nil, fmt.Errorf("query %q: parameter $%d could not be resolved", name, ref.Number)
+		}
+		col.IsSqlcOptional = true
+
+		start := assignmentStart(rawSQL, ref.Location-raw.StmtLocation)
+		if start < 0 {
+			return nil, fmt.Errorf("query %q: can't find the assignment of column %q", name, col.Name)
+		}
+		edits = append(edits, source.Edit{
+			Location: start,
+			New:      fmt.Sprintf("/*PATCH:%s*/", col.Name),
+		})
+	}
+	return edits, nil
+}
+
+// assignmentStart walks back from the parameter of `column = ?` to the start
+// of the column name
+func assignmentStart(sql string, param int) int {
+	i := param - 1
+	for i >= 0 && isSpace(sql[i]) {
+		i--
+	}
+	if i < 0 || sql[i] != '=' {
+		return -1
+	}
+	i--
+	for i >= 0 && isSpace(sql[i]) {
+		i--
+	}
+	end := i
+	for i >= 0 && !isSpace(sql[i]) && sql[i] != ',' {
+		i--
+	}
+	if i == end {
+		return -1
+	}
+	return i + 1
+}
+
+func isSpace(c byte) bool {
+	return c == ' ' || c == '\t' || c == '\n' || c == '\r'
+}
+
+func isNumberedParam(s string) bool {
+	if len(s) < 2 || s[0] != '?' {
+		return false
+	}
+	return strings.Trim(s[1:], "0123456789") == ""
+}
@@ -2,6 +2,7 @@ package compiler
 
 import (
 	"github.com/ZeyuRemtes/sqlc/internal/sql/ast"
+	"github.com/ZeyuRemtes/sqlc/internal/sql/sqlerr"
 )
 
 type Function struct {
@@ -32,8 +33,10 @@ type Column struct {
 	TableAlias string
 	Type       *ast.TypeName
 	EmbedTable *ast.TableName
+	EmbedMany  bool // is this sqlc.embed_many()
 
-	IsSqlcSlice bool // is this sqlc.slice()
+	IsSqlcSlice    bool // is this sqlc.slice()
+	IsSqlcOptional bool // can this parameter be left out, see sqlc.optional() and :patch
 
 	skipTableRequiredCheck bool
 }
@@ -42,6 +45,7 @@ type Query struct {
 	SQL      string
 	Name     string
 	Cmd      string // TODO: Pick a better name. One of: one, many, exec, execrows, copyFrom
+	Options  []string
 	Columns  []*Column
 	Params   []Parameter
 	Comments []string
@@ -49,11 +53,18 @@ type Query struct {
 	// XXX: Hack
 	Filename string
 
+	// Where the query starts, to report problems found after compilation
+	Path   string
+	Line   int
+	Column int
+
 	// Needed for CopyFrom
 	InsertIntoTable *ast.TableName
 
 	// Needed for vet
 	RawStmt *ast.RawStmt
+
+	warnings []*sqlerr.Error
 }
 
 type Parameter struct {
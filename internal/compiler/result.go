@@ -1,10 +1,12 @@
 package compiler
 
 import (
+	"github.com/ZeyuRemtes/sqlc/internal/multierr"
 	"github.com/ZeyuRemtes/sqlc/internal/sql/catalog"
 )
 
 type Result struct {
-	Catalog *catalog.Catalog
-	Queries []*Query
+	Catalog  *catalog.Catalog
+	Queries  []*Query
+	Warnings []*multierr.FileError
 }
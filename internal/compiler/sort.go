@@ -0,0 +1,196 @@
+package compiler
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ZeyuRemtes/sqlc/internal/source"
+	"github.com/ZeyuRemtes/sqlc/internal/sql/ast"
+	"github.com/ZeyuRemtes/sqlc/internal/sql/astutils"
+	"github.com/ZeyuRemtes/sqlc/internal/sql/sqlerr"
+)
+
+// sortEdits resolves the columns of `sqlc.sort(...)` and replaces the call
+// with an `/*SORT:a,b*/a ASC` placeholder. Generated code swaps the
+// placeholder for one of the listed columns and a direction, so only
+// identifiers checked against the catalog ever reach the query.
+func (c *Compiler) sortEdits(qc *QueryCatalog, raw *ast.RawStmt, rawSQL string) ([]source.Edit, error) {
+	calls := astutils.Search(raw.Stmt, isSort)
+	if len(calls.Items) == 0 {
+		return nil, nil
+	}
+	call := calls.Items[0].(*ast.FuncCall)
+	if len(calls.Items) > 1 {
+		return nil, &sqlerr.Error{
+			Message:  "sqlc.sort can only be used once per query",
+			Location: calls.Items[1].(*ast.FuncCall).Location,
+		}
+	}
+	sel, ok := raw.Stmt.(*ast.SelectStmt)
+	if !ok || !inOrderBy(sel, call) {
+		return nil, &sqlerr.Error{
+			Message:  "sqlc.sort can only be used as an ORDER BY item",
+			Location: call.Location,
+		}
+	}
+
+	tables, err := c.sourceTables(qc, sel)
+	if err != nil {
+		return nil, err
+	}
+	columns, err := sortColumns(call, tables)
+	if err != nil {
+		return nil, err
+	}
+
+	start := call.Location - raw.StmtLocation
+	end := closingParen(rawSQL, start)
+	if end < 0 {
+		return nil, &sqlerr.Error{
+			Message:  "sqlc.sort is missing its closing parenthesis",
+			Location: call.Location,
+		}
+	}
+	next := strings.Fields(strings.ToUpper(rawSQL[end+1:]))
+	if len(next) > 0 && (strings.HasPrefix(next[0], "ASC") || strings.HasPrefix(next[0], "DESC")) {
+		return nil, &sqlerr.Error{
+			Message:  "sqlc.sort sets the sort direction, remove ASC or DESC",
+			Location: call.Location,
+		}
+	}
+	return []source.Edit{{
+		Location: start,
+		Old:      rawSQL[start : end+1],
+		New:      fmt.Sprintf("/*SORT:%s*/%s ASC", strings.Join(columns, ","), columns[0]),
+	}}, nil
+}
+
+// sortColumns returns the columns a query can be sorted by. A single
+// reference to a table of the query stands for all of its columns.
+func sortColumns(call *ast.FuncCall, tables []*Table) ([]string, error) {
+	var refs [][]string
+	for _, arg := range call.Args.Items {
+		switch n := arg.(type) {
+		case *ast.A_Const:
+			str, ok := n.Val.(*ast.String)
+			if !ok {
+				return nil, &sqlerr.Error{
+					Message:  "expected parameter to sqlc.sort to be string or reference",
+					Location: call.Location,
+				}
+			}
+			refs = append(refs, strings.Split(str.Str, "."))
+		case *ast.ColumnRef:
+			refs = append(refs, stringSlice(n.Fields))
+		}
+	}
+
+	if len(refs) == 1 && len(refs[0]) == 1 {
+		for _, t := range tables {
+			if t.Rel.Name != refs[0][0] {
+				continue
+			}
+			var columns []string
+			for _, col := range t.Columns {
+				columns = append(columns, t.Rel.Name+"."+col.Name)
+			}
+			return columns, nil
+		}
+	}
+
+	var columns []string
+	seen := map[string]bool{}
+	for _, ref := range refs {
+		name := ref[len(ref)-1]
+		var found int
+		for _, t := range tables {
+			if len(ref) == 2 && t.Rel.Name != ref[0] {
+				continue
+			}
+			for _, col := range t.Columns {
+				if col.Name == name {
+					found++
+				}
+			}
+		}
+		switch {
+		case len(ref) > 2:
+			return nil, &sqlerr.Error{
+				Message:  fmt.Sprintf("invalid sqlc.sort column %q", strings.Join(ref, ".")),
+				Location: call.Location,
+			}
+		case found == 0:
+			return nil, &sqlerr.Error{
+				Code:     "42703",
+				Message:  fmt.Sprintf("column %q does not exist", strings.Join(ref, ".")),
+				Location: call.Location,
+			}
+		case found > 1:
+			return nil, &sqlerr.Error{
+				Code:     "42702",
+				Message:  fmt.Sprintf("column reference %q is ambiguous", name),
+				Location: call.Location,
+			}
+		case seen[name]:
+			return nil, &sqlerr.Error{
+				Message:  fmt.Sprintf("sqlc.sort lists column %q twice", name),
+				Location: call.Location,
+			}
+		}
+		seen[name] = true
+		columns = append(columns, strings.Join(ref, "."))
+	}
+	return columns, nil
+}
+
+func isSort(node ast.Node) bool {
+	call, ok := node.(*ast.FuncCall)
+	if !ok || call.Func == nil {
+		return false
+	}
+	return call.Func.Schema == "sqlc" && call.Func.Name == "sort"
+}
+
+// inOrderBy reports whether call is an item of the ORDER BY clause. The
+// engines keep ORDER BY items in the window clause.
+func inOrderBy(sel *ast.SelectStmt, call *ast.FuncCall) bool {
+	if sel.WindowClause == nil {
+		return false
+	}
+	for _, clause := range sel.WindowClause.Items {
+		list, ok := clause.(*ast.List)
+		if !ok {
+			continue
+		}
+		for _, item := range list.Items {
+			if expr, ok := item.(*ast.CaseExpr); ok && expr.Xpr == ast.Node(call) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// closingParen returns the position of the parenthesis that closes the first
+// one after start, skipping quoted strings and identifiers
+func closingParen(sql string, start int) int {
+	var depth int
+	for i := start; i < len(sql); i++ {
+		switch c := sql[i]; c {
+		case '\'', '"', '`':
+			end := strings.IndexByte(sql[i+1:], c)
+			if end < 0 {
+				return -1
+			}
+			i += end + 1
+		case '(':
+			depth++
+		case ')':
+			depth--
+			if depth == 0 {
+				return i
+			}
+		}
+	}
+	return -1
+}
@@ -0,0 +1,114 @@
+package compiler
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ZeyuRemtes/sqlc/internal/config"
+	"github.com/ZeyuRemtes/sqlc/internal/source"
+)
+
+// engineVariants keeps the statements of a query file meant for engine. A
+// query can be followed by variants for other engines, each starting with an
+// `-- engine:` comment listing the engines it's written for:
+//
+//	-- name: UpsertAuthor :exec
+//	INSERT INTO authors (name) VALUES (?) ON DUPLICATE KEY UPDATE name = name;
+//	-- engine: sqlite
+//	INSERT INTO authors (name) VALUES (?) ON CONFLICT DO NOTHING;
+//
+// The variant of the engine replaces the first statement, which is used by
+// the engines without one. The name comment, and the comments following it,
+// are kept for every engine. Lines that are left out are blanked, so positions
+// in the file don't change. On error, the offset of the line at fault is
+// returned.
+func engineVariants(engine config.Engine, src string) (string, int, error) {
+	if !strings.Contains(src, "-- engine:") {
+		return src, 0, nil
+	}
+	lines := strings.SplitAfter(src, "\n")
+	keep := make([]bool, len(lines))
+
+	// The lines of the current query: its first statement, then each variant
+	var sections [][]int
+	var chosen int
+	var engines map[string]bool
+	flush := func() {
+		for i, section := range sections {
+			for _, n := range section {
+				keep[n] = keep[n] || i == chosen
+			}
+		}
+		sections = nil
+		chosen = 0
+	}
+
+	header := false // whether the comments following the name comment are read
+	offset := 0
+	for n, line := range lines {
+		annotation := strings.TrimSpace(line)
+		switch {
+		case isNameComment(line):
+			flush()
+			keep[n] = true
+			header = true
+			engines = map[string]bool{}
+			sections = [][]int{nil}
+		case strings.HasPrefix(line, "-- engine:"):
+			if sections == nil {
+				return "", offset, fmt.Errorf("engine variant before any query: %s", annotation)
+			}
+			listed := strings.FieldsFunc(strings.TrimPrefix(annotation, "-- engine:"), func(r rune) bool {
+				return r == ',' || r == ' '
+			})
+			if len(listed) == 0 {
+				return "", offset, fmt.Errorf("engine variant without an engine: %s", annotation)
+			}
+			for _, e := range listed {
+				switch config.Engine(e) {
+				case config.EngineMySQL, config.EnginePostgreSQL, config.EngineSQLite:
+				default:
+					return "", offset, fmt.Errorf("unknown engine %q: %s", e, annotation)
+				}
+				if engines[e] {
+					return "", offset, fmt.Errorf("query already has a variant for %s: %s", e, annotation)
+				}
+				engines[e] = true
+				if config.Engine(e) == engine {
+					chosen = len(sections)
+				}
+			}
+			header = false
+			sections = append(sections, nil)
+		case sections == nil:
+			keep[n] = true
+		case header && strings.HasPrefix(line, "--"):
+			keep[n] = true
+		default:
+			header = false
+			sections[len(sections)-1] = append(sections[len(sections)-1], n)
+		}
+		offset += len(line)
+	}
+	flush()
+
+	var b strings.Builder
+	for n, line := range lines {
+		if keep[n] {
+			b.WriteString(line)
+		} else {
+			b.WriteString(source.Blank(line))
+		}
+	}
+	return b.String(), 0, nil
+}
+
+// isNameComment reports whether line holds the name of a query
+func isNameComment(line string) bool {
+	for _, prefix := range []string{"-- name:", "/* name:", "# name:"} {
+		if strings.HasPrefix(line, prefix) {
+			return true
+		}
+	}
+	return false
+}
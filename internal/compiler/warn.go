@@ -0,0 +1,183 @@
+package compiler
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ZeyuRemtes/sqlc/internal/config"
+	"github.com/ZeyuRemtes/sqlc/internal/metadata"
+	"github.com/ZeyuRemtes/sqlc/internal/source"
+	"github.com/ZeyuRemtes/sqlc/internal/sql/ast"
+	"github.com/ZeyuRemtes/sqlc/internal/sql/astutils"
+	"github.com/ZeyuRemtes/sqlc/internal/sql/named"
+	"github.com/ZeyuRemtes/sqlc/internal/sql/sqlerr"
+)
+
+// Warnings are non-fatal problems found while compiling a query. They are
+// collected on the Result and reported next to errors, but only fail the
+// build when strict mode is enabled.
+
+func warning(loc int, format string, args ...interface{}) *sqlerr.Error {
+	return &sqlerr.Error{
+		Message:  fmt.Sprintf(format, args...),
+		Location: loc,
+	}
+}
+
+func paramFuncName(call *ast.FuncCall) string {
+	if call.Args == nil || len(call.Args.Items) == 0 {
+		return ""
+	}
+	switch n := call.Args.Items[0].(type) {
+	case *ast.ColumnRef:
+		return astutils.Join(n.Fields, ".")
+	case *ast.A_Const:
+		if s, ok := n.Val.(*ast.String); ok {
+			return s.Str
+		}
+	}
+	return ""
+}
+
+// A named parameter declared with both sqlc.arg() and sqlc.narg() is merged
+// into a single nullable parameter, which is rarely what the author intended.
+func namedParamWarnings(raw *ast.RawStmt) []*sqlerr.Error {
+	var warns []*sqlerr.Error
+	nullable := map[string]bool{}
+	for _, item := range astutils.Search(raw, named.IsParamFunc).Items {
+		call := item.(*ast.FuncCall)
+		if call.Func.Name == "slice" {
+			continue
+		}
+		name := paramFuncName(call)
+		if name == "" {
+			continue
+		}
+		isNarg := call.Func.Name == "narg"
+		prev, ok := nullable[name]
+		if ok && prev != isNarg {
+			warns = append(warns, warning(call.Location, "named parameter %q is declared with both sqlc.arg and sqlc.narg; it will be nullable", name))
+			continue
+		}
+		nullable[name] = isNarg
+	}
+	return warns
+}
+
+func unusedCTEWarnings(node ast.Node) []*sqlerr.Error {
+	var with *ast.WithClause
+	switch n := node.(type) {
+	case *ast.DeleteStmt:
+		with = n.WithClause
+	case *ast.InsertStmt:
+		with = n.WithClause
+	case *ast.UpdateStmt:
+		with = n.WithClause
+	case *ast.SelectStmt:
+		with = n.WithClause
+	}
+	if with == nil || with.Ctes == nil {
+		return nil
+	}
+	used := map[string]struct{}{}
+	for _, rv := range rangeVars(node) {
+		if rv.Relname != nil {
+			used[*rv.Relname] = struct{}{}
+		}
+	}
+	var warns []*sqlerr.Error
+	for _, item := range with.Ctes.Items {
+		cte, ok := item.(*ast.CommonTableExpr)
+		if !ok || cte.Ctename == nil {
+			continue
+		}
+		if _, found := used[*cte.Ctename]; !found {
+			warns = append(warns, warning(cte.Location, "common table expression %q is never used", *cte.Ctename))
+		}
+	}
+	return warns
+}
+
+func execReturningWarnings(node ast.Node, name, cmd string) []*sqlerr.Error {
+	if cmd != metadata.CmdExec {
+		return nil
+	}
+	var list *ast.List
+	switch n := node.(type) {
+	case *ast.DeleteStmt:
+		list = n.ReturningList
+	case *ast.InsertStmt:
+		list = n.ReturningList
+	case *ast.UpdateStmt:
+		list = n.ReturningList
+	}
+	if list == nil || len(list.Items) == 0 {
+		return nil
+	}
+	// Expressions and stars aren't kept in the order they're written in
+	loc := list.Items[0].Pos()
+	for _, item := range list.Items[1:] {
+		if item.Pos() < loc {
+			loc = item.Pos()
+		}
+	}
+	return []*sqlerr.Error{warning(loc, "query %q uses %s but has a RETURNING clause; the returned rows are discarded", name, cmd)}
+}
+
+func starExpansionWarnings(raw *ast.RawStmt, edits []source.Edit) []*sqlerr.Error {
+	var warns []*sqlerr.Error
+	for _, edit := range edits {
+		// sqlc.embed() is rewritten through the same mechanism
+		if !strings.HasSuffix(edit.Old, "*") {
+			continue
+		}
+		warns = append(warns, warning(raw.StmtLocation+edit.Location, "%q expanded to %s", edit.Old, edit.New))
+	}
+	return warns
+}
+
+// An output column matched by more than one column override with different
+// Go types only gets the first one; the rest are silently ignored.
+func (c *Compiler) overrideWarnings(loc int, cols []*Column) []*sqlerr.Error {
+	var warns []*sqlerr.Error
+	for _, col := range cols {
+		if col.Table == nil {
+			continue
+		}
+		name := col.Name
+		if col.OriginalName != "" {
+			name = col.OriginalName
+		}
+		var types []string
+		for _, o := range c.combo.Overrides {
+			if o.GoTypeName == "" || o.ColumnName == nil {
+				continue
+			}
+			if !c.overrideMatches(o, col.Table) || !o.ColumnName.MatchString(name) {
+				continue
+			}
+			types = append(types, o.GoTypeName)
+		}
+		for i := 1; i < len(types); i++ {
+			if types[i] != types[0] {
+				warns = append(warns, warning(loc, "column %q is matched by multiple overrides (%s); using %s", name, strings.Join(types, ", "), types[0]))
+				break
+			}
+		}
+	}
+	return warns
+}
+
+// overrideMatches reports whether a column override applies to table. The
+// table of a two-part column name is in the default schema of the engine, as
+// when overrides are passed to the code generators.
+func (c *Compiler) overrideMatches(o config.Override, table *ast.TableName) bool {
+	if strings.Count(o.Column, ".") != 1 {
+		return o.Matches(table, c.catalog.DefaultSchema)
+	}
+	schema := table.Schema
+	if schema == "" {
+		schema = c.catalog.DefaultSchema
+	}
+	return schema == c.catalog.DefaultSchema && o.TableRel.MatchString(table.Name)
+}
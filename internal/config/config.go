@@ -69,7 +69,8 @@ type Project struct {
 }
 
 type Database struct {
-	URL string `json:"url" yaml:"url"`
+	URL        string `json:"url" yaml:"url"`
+	Introspect bool   `json:"introspect" yaml:"introspect"`
 }
 
 type Cloud struct {
@@ -105,15 +106,27 @@ type GenGo struct {
 }
 
 type SQL struct {
-	Engine               Engine    `json:"engine,omitempty" yaml:"engine"`
-	Schema               Paths     `json:"schema" yaml:"schema"`
-	Queries              Paths     `json:"queries" yaml:"queries"`
-	Database             *Database `json:"database" yaml:"database"`
-	StrictFunctionChecks bool      `json:"strict_function_checks" yaml:"strict_function_checks"`
-	StrictOrderBy        *bool     `json:"strict_order_by" yaml:"strict_order_by"`
-	Gen                  SQLGen    `json:"gen" yaml:"gen"`
-	Codegen              []Codegen `json:"codegen" yaml:"codegen"`
-	Rules                []string  `json:"rules" yaml:"rules"`
+	Engine               Engine     `json:"engine,omitempty" yaml:"engine"`
+	Schema               Paths      `json:"schema" yaml:"schema"`
+	Queries              Paths      `json:"queries" yaml:"queries"`
+	Migrations           string     `json:"migrations" yaml:"migrations"`
+	Database             *Database  `json:"database" yaml:"database"`
+	StrictFunctionChecks bool       `json:"strict_function_checks" yaml:"strict_function_checks"`
+	StrictOrderBy        *bool      `json:"strict_order_by" yaml:"strict_order_by"`
+	WarnStarExpansion    bool       `json:"warn_star_expansion" yaml:"warn_star_expansion"`
+	Functions            []Function `json:"functions" yaml:"functions"`
+	Gen                  SQLGen     `json:"gen" yaml:"gen"`
+	Codegen              []Codegen  `json:"codegen" yaml:"codegen"`
+	Rules                []string   `json:"rules" yaml:"rules"`
+}
+
+// Function declares a SQL function that exists at runtime but isn't defined
+// in the schema, such as a user-defined function registered by the driver.
+type Function struct {
+	Name     string   `json:"name" yaml:"name"`
+	Args     []string `json:"args" yaml:"args"`
+	Returns  string   `json:"returns" yaml:"returns"`
+	Nullable bool     `json:"nullable" yaml:"nullable"`
 }
 
 // TODO: Figure out a better name for this
@@ -143,6 +156,9 @@ type SQLGo struct {
 	EmitPointersForNullTypes    bool              `json:"emit_pointers_for_null_types" yaml:"emit_pointers_for_null_types"`
 	EmitEnumValidMethod         bool              `json:"emit_enum_valid_method,omitempty" yaml:"emit_enum_valid_method"`
 	EmitAllEnumValues           bool              `json:"emit_all_enum_values,omitempty" yaml:"emit_all_enum_values"`
+	EmitTxHelpers               bool              `json:"emit_tx_helpers,omitempty" yaml:"emit_tx_helpers"`
+	EmitReadReplica             bool              `json:"emit_read_replica,omitempty" yaml:"emit_read_replica"`
+	EmitHooks                   bool              `json:"emit_hooks,omitempty" yaml:"emit_hooks"`
 	JSONTagsCaseStyle           string            `json:"json_tags_case_style,omitempty" yaml:"json_tags_case_style"`
 	Package                     string            `json:"package" yaml:"package"`
 	Out                         string            `json:"out" yaml:"out"`
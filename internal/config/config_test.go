@@ -91,6 +91,32 @@ func TestInvalidConfig(t *testing.T) {
 	}
 }
 
+func TestInvalidFunctionConfig(t *testing.T) {
+	for _, fn := range []Function{
+		{Returns: "text"},
+		{Name: "slugify", Args: []string{"text"}},
+	} {
+		err := Validate(&Config{
+			SQL: []SQL{{
+				Functions: []Function{fn},
+			}}})
+		if err == nil {
+			t.Errorf("expected err for %+v; got nil", fn)
+		}
+	}
+}
+
+func TestInvalidMigrationsConfig(t *testing.T) {
+	err := Validate(&Config{SQL: []SQL{{Migrations: "alembic"}}})
+	if err == nil {
+		t.Errorf("expected err for an unknown migrations tool; got nil")
+	}
+	err = Validate(&Config{SQL: []SQL{{Migrations: "flyway"}}})
+	if err != nil {
+		t.Errorf("expected no err for flyway; got %s", err)
+	}
+}
+
 func TestTypeOverrides(t *testing.T) {
 	for _, test := range []struct {
 		override Override
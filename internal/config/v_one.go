@@ -25,6 +25,7 @@ type v1PackageSettings struct {
 	Path                      string     `json:"path" yaml:"path"`
 	Schema                    Paths      `json:"schema" yaml:"schema"`
 	Queries                   Paths      `json:"queries" yaml:"queries"`
+	Migrations                string     `json:"migrations" yaml:"migrations"`
 	EmitInterface             bool       `json:"emit_interface" yaml:"emit_interface"`
 	EmitJSONTags              bool       `json:"emit_json_tags" yaml:"emit_json_tags"`
 	JsonTagsIDUppercase       bool       `json:"json_tags_id_uppercase" yaml:"json_tags_id_uppercase"`
@@ -39,6 +40,9 @@ type v1PackageSettings struct {
 	EmitPointersForNullTypes  bool       `json:"emit_pointers_for_null_types" yaml:"emit_pointers_for_null_types"`
 	EmitEnumValidMethod       bool       `json:"emit_enum_valid_method,omitempty" yaml:"emit_enum_valid_method"`
 	EmitAllEnumValues         bool       `json:"emit_all_enum_values,omitempty" yaml:"emit_all_enum_values"`
+	EmitTxHelpers             bool       `json:"emit_tx_helpers,omitempty" yaml:"emit_tx_helpers"`
+	EmitReadReplica           bool       `json:"emit_read_replica,omitempty" yaml:"emit_read_replica"`
+	EmitHooks                 bool       `json:"emit_hooks,omitempty" yaml:"emit_hooks"`
 	JSONTagsCaseStyle         string     `json:"json_tags_case_style,omitempty" yaml:"json_tags_case_style"`
 	SQLPackage                string     `json:"sql_package" yaml:"sql_package"`
 	SQLDriver                 string     `json:"sql_driver" yaml:"sql_driver"`
@@ -50,6 +54,8 @@ type v1PackageSettings struct {
 	OutputFilesSuffix         string     `json:"output_files_suffix,omitempty" yaml:"output_files_suffix"`
 	StrictFunctionChecks      bool       `json:"strict_function_checks" yaml:"strict_function_checks"`
 	StrictOrderBy             *bool      `json:"strict_order_by" yaml:"strict_order_by"`
+	WarnStarExpansion         bool       `json:"warn_star_expansion" yaml:"warn_star_expansion"`
+	Functions                 []Function `json:"functions" yaml:"functions"`
 	QueryParameterLimit       *int32     `json:"query_parameter_limit,omitempty" yaml:"query_parameter_limit"`
 	OmitUnusedStructs         bool       `json:"omit_unused_structs,omitempty" yaml:"omit_unused_structs"`
 	Rules                     []string   `json:"rules" yaml:"rules"`
@@ -142,11 +148,12 @@ func (c *V1GenerateSettings) Translate() Config {
 			pkg.StrictOrderBy = &defaultValue
 		}
 		conf.SQL = append(conf.SQL, SQL{
-			Engine:   pkg.Engine,
-			Database: pkg.Database,
-			Schema:   pkg.Schema,
-			Queries:  pkg.Queries,
-			Rules:    pkg.Rules,
+			Engine:     pkg.Engine,
+			Database:   pkg.Database,
+			Schema:     pkg.Schema,
+			Queries:    pkg.Queries,
+			Migrations: pkg.Migrations,
+			Rules:      pkg.Rules,
 			Gen: SQLGen{
 				Go: &SQLGo{
 					EmitInterface:             pkg.EmitInterface,
@@ -163,6 +170,9 @@ func (c *V1GenerateSettings) Translate() Config {
 					EmitPointersForNullTypes:  pkg.EmitPointersForNullTypes,
 					EmitEnumValidMethod:       pkg.EmitEnumValidMethod,
 					EmitAllEnumValues:         pkg.EmitAllEnumValues,
+					EmitTxHelpers:             pkg.EmitTxHelpers,
+					EmitReadReplica:           pkg.EmitReadReplica,
+					EmitHooks:                 pkg.EmitHooks,
 					Package:                   pkg.Name,
 					Out:                       pkg.Path,
 					SQLPackage:                pkg.SQLPackage,
@@ -180,6 +190,8 @@ func (c *V1GenerateSettings) Translate() Config {
 			},
 			StrictFunctionChecks: pkg.StrictFunctionChecks,
 			StrictOrderBy:        pkg.StrictOrderBy,
+			WarnStarExpansion:    pkg.WarnStarExpansion,
+			Functions:            pkg.Functions,
 		})
 	}
 
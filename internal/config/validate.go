@@ -1,9 +1,24 @@
 package config
 
-import "fmt"
+import (
+	"fmt"
+
+	"github.com/ZeyuRemtes/sqlc/internal/migrations"
+)
 
 func Validate(c *Config) error {
 	for _, sql := range c.SQL {
+		if sql.Migrations != "" && !migrations.IsTool(sql.Migrations) {
+			return fmt.Errorf("invalid config: unknown migrations tool %q", sql.Migrations)
+		}
+		for _, fn := range sql.Functions {
+			if fn.Name == "" {
+				return fmt.Errorf("invalid config: functions must have a name")
+			}
+			if fn.Returns == "" {
+				return fmt.Errorf("invalid config: function %s must have a return type", fn.Name)
+			}
+		}
 		sqlGo := sql.Gen.Go
 		if sqlGo == nil {
 			continue
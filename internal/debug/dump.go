@@ -13,9 +13,7 @@ var Debug opts.Debug
 
 func init() {
 	Active = os.Getenv("SQLCDEBUG") != ""
-	if Active {
-		Debug = opts.DebugFromEnv()
-	}
+	Debug = opts.DebugFromEnv()
 }
 
 func Dump(n ...interface{}) {
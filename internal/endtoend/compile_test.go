@@ -0,0 +1,121 @@
+package main
+
+import (
+	"fmt"
+	"go/ast"
+	"go/importer"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"io"
+	"os"
+	osexec "os/exec"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// TestReplayCompiles type-checks the Go code expected from each test case, so
+// that generated code which doesn't build can't be accepted as the expected
+// output. Packages importing one this module doesn't require are skipped.
+func TestReplayCompiles(t *testing.T) {
+	t.Parallel()
+
+	fset := token.NewFileSet()
+	pkgs := map[string][]*ast.File{}
+	err := filepath.Walk("testdata", func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() || !strings.HasSuffix(path, ".go") || strings.HasSuffix(path, "_test.go") {
+			return nil
+		}
+		f, err := parser.ParseFile(fset, path, nil, 0)
+		if err != nil {
+			return err
+		}
+		pkgs[filepath.Dir(path)] = append(pkgs[filepath.Dir(path)], f)
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	paths := map[string]struct{}{}
+	for _, files := range pkgs {
+		for _, path := range importPaths(files) {
+			paths[path] = struct{}{}
+		}
+	}
+	exports := exportData(paths)
+	imp := importer.ForCompiler(fset, "gc", func(path string) (io.ReadCloser, error) {
+		file, ok := exports[path]
+		if !ok {
+			return nil, fmt.Errorf("no export data for %s", path)
+		}
+		return os.Open(file)
+	})
+
+	dirs := make([]string, 0, len(pkgs))
+	for dir := range pkgs {
+		dirs = append(dirs, dir)
+	}
+	sort.Strings(dirs)
+	for _, dir := range dirs {
+		files := pkgs[dir]
+		missing := false
+		for _, path := range importPaths(files) {
+			if _, ok := exports[path]; !ok {
+				missing = true
+			}
+		}
+		if missing {
+			continue
+		}
+		// The importer isn't safe for concurrent use, so packages are checked
+		// one at a time
+		t.Run(dir, func(t *testing.T) {
+			conf := types.Config{
+				Importer: imp,
+				Error: func(err error) {
+					t.Error(err)
+				},
+			}
+			conf.Check(files[0].Name.Name, fset, files, nil)
+		})
+	}
+}
+
+func importPaths(files []*ast.File) []string {
+	var paths []string
+	for _, f := range files {
+		for _, spec := range f.Imports {
+			path, err := strconv.Unquote(spec.Path.Value)
+			if err == nil {
+				paths = append(paths, path)
+			}
+		}
+	}
+	return paths
+}
+
+// exportData returns the export data files of the packages at paths, leaving
+// out the ones that can't be built from this module. Packages are listed one at
+// a time, as in -mod=readonly mode a package from a module the go.mod file
+// doesn't require fails the whole command.
+func exportData(paths map[string]struct{}) map[string]string {
+	exports := map[string]string{}
+	for path := range paths {
+		cmd := osexec.Command("go", "list", "-export", "-mod=readonly", "-f", "{{.Export}}", path)
+		out, err := cmd.Output()
+		if err != nil {
+			continue
+		}
+		if file := strings.TrimSpace(string(out)); file != "" {
+			exports[path] = file
+		}
+	}
+	return exports
+}
@@ -17,6 +17,18 @@ import (
 	"github.com/ZeyuRemtes/sqlc/internal/opts"
 )
 
+func TestMain(m *testing.M) {
+	// Keep the catalog snapshots written by the tests out of the user's cache
+	dir, err := os.MkdirTemp("", "sqlc-cache")
+	if err != nil {
+		panic(err)
+	}
+	os.Setenv("SQLCCACHE", dir)
+	code := m.Run()
+	os.RemoveAll(dir)
+	os.Exit(code)
+}
+
 func TestExamples(t *testing.T) {
 	t.Parallel()
 	ctx := context.Background()
@@ -114,6 +126,7 @@ func TestReplay(t *testing.T) {
 			env := cmd.Env{
 				Debug:    opts.DebugFromString(args.Env["SQLCDEBUG"]),
 				NoRemote: true,
+				Strict:   args.Strict,
 			}
 			switch args.Command {
 			case "diff":
@@ -219,6 +232,7 @@ type exec struct {
 	Command string            `json:"command"`
 	Process string            `json:"process"`
 	Env     map[string]string `json:"env"`
+	Strict  bool              `json:"strict"`
 }
 
 func parseExec(t *testing.T, dir string) exec {
@@ -0,0 +1,288 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.18.0
+// source: batch.go
+
+package querytest
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+)
+
+var (
+	ErrBatchAlreadyClosed = errors.New("batch already closed")
+)
+
+// batch runs a prepared statement once for each set of arguments, in a
+// transaction if asked to.
+type batch struct {
+	ctx    context.Context
+	tx     *sql.Tx // the transaction started for the batch, committed by close
+	stmt   *sql.Stmt
+	args   [][]interface{}
+	err    error // the error preparing the batch, returned for every set of arguments
+	ran    int
+	failed bool
+	closed bool
+	result error
+}
+
+func newBatch(ctx context.Context, db DBTX, useTx bool, query string, args [][]interface{}) *batch {
+	b := &batch{ctx: ctx, args: args}
+	if useTx {
+		// db may already be a transaction, which is then used as is
+		if beginner, ok := db.(interface {
+			BeginTx(context.Context, *sql.TxOptions) (*sql.Tx, error)
+		}); ok {
+			b.tx, b.err = beginner.BeginTx(ctx, nil)
+			if b.err != nil {
+				b.failed = true
+				return b
+			}
+			db = b.tx
+		}
+	}
+	b.stmt, b.err = db.PrepareContext(ctx, query)
+	b.failed = b.err != nil
+	return b
+}
+
+func (b *batch) exec(t int) error {
+	b.ran++
+	if b.err != nil {
+		return b.err
+	}
+	_, err := b.stmt.ExecContext(b.ctx, b.args[t]...)
+	return err
+}
+
+func (b *batch) query(t int) (*sql.Rows, error) {
+	b.ran++
+	if b.err != nil {
+		return nil, b.err
+	}
+	return b.stmt.QueryContext(b.ctx, b.args[t]...)
+}
+
+// record notes the outcome of running the statement, so that close rolls the
+// transaction back after a failure.
+func (b *batch) record(err error) error {
+	if err != nil {
+		b.failed = true
+	}
+	return err
+}
+
+// close releases the statement and ends the transaction of the batch. The
+// transaction is only committed when every set of arguments ran without error.
+// Calling close again returns the same result.
+func (b *batch) close() error {
+	if b.closed {
+		return b.result
+	}
+	b.closed = true
+	if b.stmt != nil {
+		b.result = b.stmt.Close()
+	}
+	if b.tx != nil {
+		if b.failed || b.ran < len(b.args) || b.result != nil {
+			b.tx.Rollback()
+		} else {
+			b.result = b.tx.Commit()
+		}
+	}
+	return b.result
+}
+
+type GetAuthorBatchResults struct {
+	br     *batch
+	tot    int
+	closed bool
+}
+
+func (q *MysqlAccess) GetAuthor(ctx context.Context, id []int64) *GetAuthorBatchResults {
+	args := make([][]interface{}, 0, len(id))
+	for _, a := range id {
+		args = append(args, []interface{}{
+			a,
+		})
+	}
+	br := newBatch(ctx, q.db, false, getAuthorMysql, args)
+	return &GetAuthorBatchResults{br, len(id), false}
+}
+
+func (b *GetAuthorBatchResults) QueryRow(f func(int, Author, error)) {
+	defer b.br.close()
+	for t := 0; t < b.tot; t++ {
+		var i Author
+		if b.closed {
+			if f != nil {
+				f(t, i, ErrBatchAlreadyClosed)
+			}
+			continue
+		}
+		err := b.br.record(func() error {
+			rows, err := b.br.query(t)
+			if err != nil {
+				return err
+			}
+			defer rows.Close()
+			if !rows.Next() {
+				if err := rows.Err(); err != nil {
+					return err
+				}
+				return sql.ErrNoRows
+			}
+			if err := rows.Scan(&i.ID, &i.Name, &i.Bio); err != nil {
+				return err
+			}
+			return rows.Close()
+		}())
+		if f != nil {
+			f(t, i, err)
+		}
+	}
+}
+
+func (b *GetAuthorBatchResults) Close() error {
+	b.closed = true
+	return b.br.close()
+}
+
+type InsertAuthorsBatchResults struct {
+	br     *batch
+	tot    int
+	closed bool
+}
+
+func (q *MysqlAccess) InsertAuthors(ctx context.Context, arg []InsertAuthorsParams) *InsertAuthorsBatchResults {
+	args := make([][]interface{}, 0, len(arg))
+	for _, a := range arg {
+		args = append(args, []interface{}{
+			a.Name,
+			a.Bio,
+		})
+	}
+	br := newBatch(ctx, q.db, true, insertAuthorsMysql, args)
+	return &InsertAuthorsBatchResults{br, len(arg), false}
+}
+
+func (b *InsertAuthorsBatchResults) Exec(f func(int, error)) {
+	defer b.br.close()
+	for t := 0; t < b.tot; t++ {
+		if b.closed {
+			if f != nil {
+				f(t, ErrBatchAlreadyClosed)
+			}
+			continue
+		}
+		err := b.br.record(b.br.exec(t))
+		if f != nil {
+			f(t, err)
+		}
+	}
+}
+
+// Close stops the batch and ends its transaction. It returns the error
+// committing it, or nil when a statement failed and it was rolled back.
+func (b *InsertAuthorsBatchResults) Close() error {
+	b.closed = true
+	return b.br.close()
+}
+
+type ListAuthorsByNameBatchResults struct {
+	br     *batch
+	tot    int
+	closed bool
+}
+
+func (q *MysqlAccess) ListAuthorsByName(ctx context.Context, name []string) *ListAuthorsByNameBatchResults {
+	args := make([][]interface{}, 0, len(name))
+	for _, a := range name {
+		args = append(args, []interface{}{
+			a,
+		})
+	}
+	br := newBatch(ctx, q.db, false, listAuthorsByNameMysql, args)
+	return &ListAuthorsByNameBatchResults{br, len(name), false}
+}
+
+func (b *ListAuthorsByNameBatchResults) Query(f func(int, []Author, error)) {
+	defer b.br.close()
+	for t := 0; t < b.tot; t++ {
+		var items []Author
+		if b.closed {
+			if f != nil {
+				f(t, items, ErrBatchAlreadyClosed)
+			}
+			continue
+		}
+		err := b.br.record(func() error {
+			rows, err := b.br.query(t)
+			if err != nil {
+				return err
+			}
+			defer rows.Close()
+			for rows.Next() {
+				var i Author
+				if err := rows.Scan(&i.ID, &i.Name, &i.Bio); err != nil {
+					return err
+				}
+				items = append(items, i)
+			}
+			if err := rows.Close(); err != nil {
+				return err
+			}
+			return rows.Err()
+		}())
+		if f != nil {
+			f(t, items, err)
+		}
+	}
+}
+
+func (b *ListAuthorsByNameBatchResults) Close() error {
+	b.closed = true
+	return b.br.close()
+}
+
+type UpdateBioBatchResults struct {
+	br     *batch
+	tot    int
+	closed bool
+}
+
+func (q *MysqlAccess) UpdateBio(ctx context.Context, arg []UpdateBioParams) *UpdateBioBatchResults {
+	args := make([][]interface{}, 0, len(arg))
+	for _, a := range arg {
+		args = append(args, []interface{}{
+			a.Bio,
+			a.ID,
+		})
+	}
+	br := newBatch(ctx, q.db, false, updateBioMysql, args)
+	return &UpdateBioBatchResults{br, len(arg), false}
+}
+
+func (b *UpdateBioBatchResults) Exec(f func(int, error)) {
+	defer b.br.close()
+	for t := 0; t < b.tot; t++ {
+		if b.closed {
+			if f != nil {
+				f(t, ErrBatchAlreadyClosed)
+			}
+			continue
+		}
+		err := b.br.record(b.br.exec(t))
+		if f != nil {
+			f(t, err)
+		}
+	}
+}
+
+func (b *UpdateBioBatchResults) Close() error {
+	b.closed = true
+	return b.br.close()
+}
@@ -0,0 +1,37 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.18.0
+
+package querytest
+
+import (
+	"database/sql"
+	"io"
+
+	"context"
+)
+
+type DBTX interface {
+	ExecContext(context.Context, string, ...interface{}) (sql.Result, error)
+	PrepareContext(context.Context, string) (*sql.Stmt, error)
+	QueryContext(context.Context, string, ...interface{}) (*sql.Rows, error)
+	QueryRowContext(context.Context, string, ...interface{}) *sql.Row
+}
+
+type DAL interface {
+	io.Closer
+	GetAuthor(ctx context.Context, id []int64) *GetAuthorBatchResults
+	InsertAuthors(ctx context.Context, arg []InsertAuthorsParams) *InsertAuthorsBatchResults
+	ListAuthorsByName(ctx context.Context, name []string) *ListAuthorsByNameBatchResults
+	UpdateBio(ctx context.Context, arg []UpdateBioParams) *UpdateBioBatchResults
+}
+
+type InsertAuthorsParams struct {
+	Name string
+	Bio  sql.NullString
+}
+
+type UpdateBioParams struct {
+	Bio sql.NullString
+	ID  int64
+}
@@ -0,0 +1,22 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.18.0
+// source: query.sql
+
+package querytest
+
+const getAuthorSqlite = `-- name: GetAuthor :batchone
+SELECT id, name, bio FROM authors WHERE id = ?
+`
+
+const insertAuthorsSqlite = `-- name: InsertAuthors :batchexec
+INSERT INTO authors (name, bio) VALUES (?, ?)
+`
+
+const listAuthorsByNameSqlite = `-- name: ListAuthorsByName :batchmany
+SELECT id, name, bio FROM authors WHERE name = ?
+`
+
+const updateBioSqlite = `-- name: UpdateBio :batchexec
+UPDATE authors SET bio = ? WHERE id = ?
+`
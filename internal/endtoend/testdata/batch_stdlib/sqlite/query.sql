@@ -0,0 +1,11 @@
+-- name: InsertAuthors :batchexec tx=true
+INSERT INTO authors (name, bio) VALUES (?, ?);
+
+-- name: UpdateBio :batchexec
+UPDATE authors SET bio = ? WHERE id = ?;
+
+-- name: ListAuthorsByName :batchmany
+SELECT id, name, bio FROM authors WHERE name = ?;
+
+-- name: GetAuthor :batchone
+SELECT id, name, bio FROM authors WHERE id = ?;
@@ -0,0 +1,2 @@
+-- name: ListAuthorsByIDs :batchmany
+SELECT id, name, bio FROM authors WHERE id IN (sqlc.slice(ids));
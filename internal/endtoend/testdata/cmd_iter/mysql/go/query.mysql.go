@@ -0,0 +1,60 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.18.0
+// source: query.sql
+
+package querytest
+
+import "context"
+
+const exportUserEmailsMysql = `-- name: ExportUserEmails :iter
+SELECT email FROM users
+WHERE id > ? AND name = ?
+`
+
+func (q *MysqlAccess) ExportUserEmails(ctx context.Context, arg ExportUserEmailsParams, fn func(string) error) error {
+	rows, err := q.db.QueryContext(ctx, exportUserEmailsMysql, arg.ID, arg.Name)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var email string
+		if err := rows.Scan(&email); err != nil {
+			return err
+		}
+		if err := fn(email); err != nil {
+			return err
+		}
+	}
+	if err := rows.Close(); err != nil {
+		return err
+	}
+	return rows.Err()
+}
+
+const exportUsersMysql = `-- name: ExportUsers :iter
+SELECT id, email, name FROM users
+ORDER BY id
+`
+
+func (q *MysqlAccess) ExportUsers(ctx context.Context, fn func(User) error) error {
+	rows, err := q.db.QueryContext(ctx, exportUsersMysql)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var i User
+		if err := rows.Scan(&i.ID, &i.Email, &i.Name); err != nil {
+			return err
+		}
+		if err := fn(i); err != nil {
+			return err
+		}
+	}
+	if err := rows.Close(); err != nil {
+		return err
+	}
+	return rows.Err()
+}
@@ -0,0 +1,7 @@
+-- name: ExportUsers :iter
+SELECT id, email, name FROM users
+ORDER BY id;
+
+-- name: ExportUserEmails :iter
+SELECT email FROM users
+WHERE id > ? AND name = ?;
@@ -0,0 +1,30 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.18.0
+
+package querytest
+
+import (
+	"database/sql"
+	"io"
+
+	"context"
+)
+
+type DBTX interface {
+	ExecContext(context.Context, string, ...interface{}) (sql.Result, error)
+	PrepareContext(context.Context, string) (*sql.Stmt, error)
+	QueryContext(context.Context, string, ...interface{}) (*sql.Rows, error)
+	QueryRowContext(context.Context, string, ...interface{}) *sql.Row
+}
+
+type DAL interface {
+	io.Closer
+	ExportUserEmails(ctx context.Context, db DBTX, arg ExportUserEmailsParams, fn func(string) error) error
+	ExportUsers(ctx context.Context, db DBTX, fn func(User) error) error
+}
+
+type ExportUserEmailsParams struct {
+	ID   int64
+	Name sql.NullString
+}
@@ -0,0 +1,60 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.18.0
+// source: query.sql
+
+package querytest
+
+import "context"
+
+const exportUserEmailsSqlite = `-- name: ExportUserEmails :iter
+SELECT email FROM users
+WHERE id > ? AND name = ?
+`
+
+func (q *SqliteAccess) ExportUserEmails(ctx context.Context, db DBTX, arg ExportUserEmailsParams, fn func(string) error) error {
+	rows, err := db.QueryContext(ctx, exportUserEmailsSqlite, arg.ID, arg.Name)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var email string
+		if err := rows.Scan(&email); err != nil {
+			return err
+		}
+		if err := fn(email); err != nil {
+			return err
+		}
+	}
+	if err := rows.Close(); err != nil {
+		return err
+	}
+	return rows.Err()
+}
+
+const exportUsersSqlite = `-- name: ExportUsers :iter
+SELECT id, email, name FROM users
+ORDER BY id
+`
+
+func (q *SqliteAccess) ExportUsers(ctx context.Context, db DBTX, fn func(User) error) error {
+	rows, err := db.QueryContext(ctx, exportUsersSqlite)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var i User
+		if err := rows.Scan(&i.ID, &i.Email, &i.Name); err != nil {
+			return err
+		}
+		if err := fn(i); err != nil {
+			return err
+		}
+	}
+	if err := rows.Close(); err != nil {
+		return err
+	}
+	return rows.Err()
+}
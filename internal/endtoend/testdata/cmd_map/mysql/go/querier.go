@@ -0,0 +1,31 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.18.0
+
+package querytest
+
+import (
+	"database/sql"
+	"io"
+
+	"context"
+)
+
+type DBTX interface {
+	ExecContext(context.Context, string, ...interface{}) (sql.Result, error)
+	PrepareContext(context.Context, string) (*sql.Stmt, error)
+	QueryContext(context.Context, string, ...interface{}) (*sql.Rows, error)
+	QueryRowContext(context.Context, string, ...interface{}) *sql.Row
+}
+
+type DAL interface {
+	io.Closer
+	NamesByEmail(ctx context.Context) (map[string]NamesByEmailRow, error)
+	UserEmails(ctx context.Context) (map[string]string, error)
+	UsersByID(ctx context.Context, name sql.NullString) (map[int64]User, error)
+}
+
+type NamesByEmailRow struct {
+	Email string
+	Name  sql.NullString
+}
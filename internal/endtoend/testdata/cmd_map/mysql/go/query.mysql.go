@@ -0,0 +1,93 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.18.0
+// source: query.sql
+
+package querytest
+
+import (
+	"context"
+	"database/sql"
+)
+
+const namesByEmailMysql = `-- name: NamesByEmail :map
+SELECT email, name FROM users
+`
+
+func (q *MysqlAccess) NamesByEmail(ctx context.Context) (map[string]NamesByEmailRow, error) {
+	rows, err := q.db.QueryContext(ctx, namesByEmailMysql)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := make(map[string]NamesByEmailRow)
+	for rows.Next() {
+		var i NamesByEmailRow
+		if err := rows.Scan(&i.Email, &i.Name); err != nil {
+			return nil, err
+		}
+		items[i.Email] = i
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const userEmailsMysql = `-- name: UserEmails :map
+SELECT email FROM users
+`
+
+func (q *MysqlAccess) UserEmails(ctx context.Context) (map[string]string, error) {
+	rows, err := q.db.QueryContext(ctx, userEmailsMysql)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := make(map[string]string)
+	for rows.Next() {
+		var email string
+		if err := rows.Scan(&email); err != nil {
+			return nil, err
+		}
+		items[email] = email
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const usersByIDMysql = `-- name: UsersByID :map
+SELECT id, email, name FROM users
+WHERE name = ?
+`
+
+func (q *MysqlAccess) UsersByID(ctx context.Context, name sql.NullString) (map[int64]User, error) {
+	rows, err := q.db.QueryContext(ctx, usersByIDMysql, name)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := make(map[int64]User)
+	for rows.Next() {
+		var i User
+		if err := rows.Scan(&i.ID, &i.Email, &i.Name); err != nil {
+			return nil, err
+		}
+		items[i.ID] = i
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
@@ -0,0 +1,9 @@
+-- name: UsersByID :map key=id
+SELECT id, email, name FROM users
+WHERE name = ?;
+
+-- name: NamesByEmail :map key=email
+SELECT email, name FROM users;
+
+-- name: UserEmails :map key=email
+SELECT email FROM users;
@@ -0,0 +1,5 @@
+CREATE TABLE users (
+  id bigint NOT NULL AUTO_INCREMENT PRIMARY KEY,
+  email varchar(255) NOT NULL,
+  name text
+);
@@ -0,0 +1,20 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.18.0
+
+package querytest
+
+var _ DAL = (*SqliteAccess)(nil)
+
+func NewSqlite() *SqliteAccess {
+	return &SqliteAccess{}
+}
+
+type SqliteAccess struct {
+}
+
+// Close lets SqliteAccess implement DAL. It has no prepared statements to
+// close.
+func (q *SqliteAccess) Close() error {
+	return nil
+}
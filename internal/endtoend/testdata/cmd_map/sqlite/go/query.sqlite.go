@@ -0,0 +1,93 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.18.0
+// source: query.sql
+
+package querytest
+
+import (
+	"context"
+	"database/sql"
+)
+
+const namesByEmailSqlite = `-- name: NamesByEmail :map
+SELECT email, name FROM users
+`
+
+func (q *SqliteAccess) NamesByEmail(ctx context.Context, db DBTX) (map[string]NamesByEmailRow, error) {
+	rows, err := db.QueryContext(ctx, namesByEmailSqlite)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := make(map[string]NamesByEmailRow)
+	for rows.Next() {
+		var i NamesByEmailRow
+		if err := rows.Scan(&i.Email, &i.Name); err != nil {
+			return nil, err
+		}
+		items[i.Email] = i
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const userEmailsSqlite = `-- name: UserEmails :map
+SELECT email FROM users
+`
+
+func (q *SqliteAccess) UserEmails(ctx context.Context, db DBTX) (map[string]string, error) {
+	rows, err := db.QueryContext(ctx, userEmailsSqlite)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := make(map[string]string)
+	for rows.Next() {
+		var email string
+		if err := rows.Scan(&email); err != nil {
+			return nil, err
+		}
+		items[email] = email
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const usersByIDSqlite = `-- name: UsersByID :map
+SELECT id, email, name FROM users
+WHERE name = ?
+`
+
+func (q *SqliteAccess) UsersByID(ctx context.Context, db DBTX, name sql.NullString) (map[int64]User, error) {
+	rows, err := db.QueryContext(ctx, usersByIDSqlite, name)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := make(map[int64]User)
+	for rows.Next() {
+		var i User
+		if err := rows.Scan(&i.ID, &i.Email, &i.Name); err != nil {
+			return nil, err
+		}
+		items[i.ID] = i
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
@@ -0,0 +1,5 @@
+-- name: UsersByName :map key=name
+SELECT id, email, name FROM users;
+
+-- name: UsersByOwner :map key=owner
+SELECT id, email, name FROM users;
@@ -0,0 +1,2 @@
+-- name: UsersByPrefs :map key=prefs
+SELECT id, prefs FROM users;
@@ -0,0 +1,4 @@
+CREATE TABLE users (
+  id bigint NOT NULL AUTO_INCREMENT PRIMARY KEY,
+  prefs json NOT NULL
+);
@@ -0,0 +1,36 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.18.0
+
+package querytest
+
+import (
+	"database/sql"
+	"io"
+
+	"context"
+)
+
+type DBTX interface {
+	ExecContext(context.Context, string, ...interface{}) (sql.Result, error)
+	PrepareContext(context.Context, string) (*sql.Stmt, error)
+	QueryContext(context.Context, string, ...interface{}) (*sql.Rows, error)
+	QueryRowContext(context.Context, string, ...interface{}) *sql.Row
+}
+
+type DAL interface {
+	io.Closer
+	GetUser(ctx context.Context, id int64) (User, bool, error)
+	GetUserByEmailAndName(ctx context.Context, arg GetUserByEmailAndNameParams) (GetUserByEmailAndNameRow, bool, error)
+	GetUserName(ctx context.Context, email string) (sql.NullString, bool, error)
+}
+
+type GetUserByEmailAndNameParams struct {
+	Email string
+	Name  sql.NullString
+}
+
+type GetUserByEmailAndNameRow struct {
+	ID    int64
+	Email string
+}
@@ -0,0 +1,57 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.18.0
+// source: query.sql
+
+package querytest
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+)
+
+const getUserMysql = `-- name: GetUser :opt
+SELECT id, email, name FROM users
+WHERE id = ?
+`
+
+func (q *MysqlAccess) GetUser(ctx context.Context, id int64) (User, bool, error) {
+	row := q.db.QueryRowContext(ctx, getUserMysql, id)
+	var i User
+	err := row.Scan(&i.ID, &i.Email, &i.Name)
+	if errors.Is(err, sql.ErrNoRows) {
+		return i, false, nil
+	}
+	return i, err == nil, err
+}
+
+const getUserByEmailAndNameMysql = `-- name: GetUserByEmailAndName :opt
+SELECT id, email FROM users
+WHERE email = ? AND name = ?
+`
+
+func (q *MysqlAccess) GetUserByEmailAndName(ctx context.Context, arg GetUserByEmailAndNameParams) (GetUserByEmailAndNameRow, bool, error) {
+	row := q.db.QueryRowContext(ctx, getUserByEmailAndNameMysql, arg.Email, arg.Name)
+	var i GetUserByEmailAndNameRow
+	err := row.Scan(&i.ID, &i.Email)
+	if errors.Is(err, sql.ErrNoRows) {
+		return i, false, nil
+	}
+	return i, err == nil, err
+}
+
+const getUserNameMysql = `-- name: GetUserName :opt
+SELECT name FROM users
+WHERE email = ? LIMIT 1
+`
+
+func (q *MysqlAccess) GetUserName(ctx context.Context, email string) (sql.NullString, bool, error) {
+	row := q.db.QueryRowContext(ctx, getUserNameMysql, email)
+	var name sql.NullString
+	err := row.Scan(&name)
+	if errors.Is(err, sql.ErrNoRows) {
+		return name, false, nil
+	}
+	return name, err == nil, err
+}
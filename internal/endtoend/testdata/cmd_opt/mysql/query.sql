@@ -0,0 +1,11 @@
+-- name: GetUser :opt
+SELECT id, email, name FROM users
+WHERE id = ?;
+
+-- name: GetUserName :opt
+SELECT name FROM users
+WHERE email = ? LIMIT 1;
+
+-- name: GetUserByEmailAndName :opt
+SELECT id, email FROM users
+WHERE email = ? AND name = ?;
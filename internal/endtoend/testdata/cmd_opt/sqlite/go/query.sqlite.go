@@ -0,0 +1,58 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.18.0
+// source: query.sql
+
+package querytest
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+)
+
+const deleteUserSqlite = `-- name: DeleteUser :opt
+DELETE FROM users
+WHERE id = ?
+RETURNING email
+`
+
+func (q *SqliteAccess) DeleteUser(ctx context.Context, id int64) (string, bool, error) {
+	row := q.db.QueryRowContext(ctx, deleteUserSqlite, id)
+	var email string
+	err := row.Scan(&email)
+	if errors.Is(err, sql.ErrNoRows) {
+		return email, false, nil
+	}
+	return email, err == nil, err
+}
+
+const getUserSqlite = `-- name: GetUser :opt
+SELECT id, email, name FROM users
+WHERE id = ?
+`
+
+func (q *SqliteAccess) GetUser(ctx context.Context, id int64) (User, bool, error) {
+	row := q.db.QueryRowContext(ctx, getUserSqlite, id)
+	var i User
+	err := row.Scan(&i.ID, &i.Email, &i.Name)
+	if errors.Is(err, sql.ErrNoRows) {
+		return i, false, nil
+	}
+	return i, err == nil, err
+}
+
+const getUserNameSqlite = `-- name: GetUserName :opt
+SELECT name FROM users
+WHERE email = ? LIMIT 1
+`
+
+func (q *SqliteAccess) GetUserName(ctx context.Context, email string) (sql.NullString, bool, error) {
+	row := q.db.QueryRowContext(ctx, getUserNameSqlite, email)
+	var name sql.NullString
+	err := row.Scan(&name)
+	if errors.Is(err, sql.ErrNoRows) {
+		return name, false, nil
+	}
+	return name, err == nil, err
+}
@@ -0,0 +1,12 @@
+-- name: GetUser :opt
+SELECT id, email, name FROM users
+WHERE id = ?;
+
+-- name: GetUserName :opt
+SELECT name FROM users
+WHERE email = ? LIMIT 1;
+
+-- name: DeleteUser :opt
+DELETE FROM users
+WHERE id = ?
+RETURNING email;
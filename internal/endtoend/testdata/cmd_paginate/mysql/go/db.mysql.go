@@ -0,0 +1,23 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.18.0
+
+package querytest
+
+import (
+	"database/sql"
+)
+
+func NewMysql(db DBTX) *MysqlAccess {
+	return &MysqlAccess{db: db}
+}
+
+type MysqlAccess struct {
+	db DBTX
+}
+
+func (q *MysqlAccess) WithTx(tx *sql.Tx) *MysqlAccess {
+	return &MysqlAccess{
+		db: tx,
+	}
+}
@@ -0,0 +1,43 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.18.0
+
+package querytest
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"time"
+)
+
+type User struct {
+	ID        int64
+	OrgID     int64
+	Name      string
+	Email     string
+	CreatedAt time.Time
+}
+
+// ErrInvalidCursor is returned by :paginate queries for a cursor that can't be
+// decoded.
+var ErrInvalidCursor = errors.New("invalid cursor")
+
+func encodeCursor(key interface{}) (string, error) {
+	b, err := json.Marshal(key)
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+func decodeCursor(cursor string, key interface{}) error {
+	b, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return ErrInvalidCursor
+	}
+	if err := json.Unmarshal(b, key); err != nil {
+		return ErrInvalidCursor
+	}
+	return nil
+}
@@ -0,0 +1,304 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.18.0
+// source: query.sql
+
+package querytest
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+)
+
+const listAllUsersMysql = `-- name: ListAllUsers :paginate
+SELECT id, org_id, name, email, created_at FROM users /*PAGINATE:WHERE:id ASC 0*/
+ORDER BY id
+`
+
+// ListAllUsersPage is a page of ListAllUsers results.
+type ListAllUsersPage struct {
+	Items []User
+	// Next is the cursor of the following page, empty on the last page
+	Next ListAllUsersCursor
+}
+
+// ListAllUsersCursor is an opaque position in the results of
+// ListAllUsers. The empty cursor starts from the first row.
+type ListAllUsersCursor string
+
+type listAllUsersKey struct {
+	ID int64
+}
+
+func (q *MysqlAccess) ListAllUsers(ctx context.Context, cursor ListAllUsersCursor, limit int) (ListAllUsersPage, error) {
+	if limit < 1 {
+		return ListAllUsersPage{}, fmt.Errorf("limit must be at least 1; got %d", limit)
+	}
+	query := listAllUsersMysql
+	var queryParams []interface{}
+	if cursor != "" {
+		var key listAllUsersKey
+		if err := decodeCursor(string(cursor), &key); err != nil {
+			return ListAllUsersPage{}, err
+		}
+		query = strings.Replace(query, "/*PAGINATE:WHERE:id ASC 0*/", "WHERE id > ?", 1)
+		queryParams = append(queryParams, key.ID)
+	}
+	query += " LIMIT ?"
+	queryParams = append(queryParams, limit+1)
+	rows, err := q.db.QueryContext(ctx, query, queryParams...)
+	if err != nil {
+		return ListAllUsersPage{}, err
+	}
+	defer rows.Close()
+	var page ListAllUsersPage
+	for rows.Next() {
+		var i User
+		if err := rows.Scan(
+			&i.ID,
+			&i.OrgID,
+			&i.Name,
+			&i.Email,
+			&i.CreatedAt,
+		); err != nil {
+			return ListAllUsersPage{}, err
+		}
+		page.Items = append(page.Items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return ListAllUsersPage{}, err
+	}
+	if err := rows.Err(); err != nil {
+		return ListAllUsersPage{}, err
+	}
+	if len(page.Items) > limit {
+		page.Items = page.Items[:limit]
+		last := page.Items[limit-1]
+		next, err := encodeCursor(listAllUsersKey{
+			ID: last.ID,
+		})
+		if err != nil {
+			return ListAllUsersPage{}, err
+		}
+		page.Next = ListAllUsersCursor(next)
+	}
+	return page, nil
+}
+
+const listEmailsMysql = `-- name: ListEmails :paginate
+SELECT email FROM users /*PAGINATE:WHERE:email ASC 0*/
+ORDER BY email
+`
+
+// ListEmailsPage is a page of ListEmails results.
+type ListEmailsPage struct {
+	Items []string
+	// Next is the cursor of the following page, empty on the last page
+	Next ListEmailsCursor
+}
+
+// ListEmailsCursor is an opaque position in the results of
+// ListEmails. The empty cursor starts from the first row.
+type ListEmailsCursor string
+
+type listEmailsKey struct {
+	Email string
+}
+
+func (q *MysqlAccess) ListEmails(ctx context.Context, cursor ListEmailsCursor, limit int) (ListEmailsPage, error) {
+	if limit < 1 {
+		return ListEmailsPage{}, fmt.Errorf("limit must be at least 1; got %d", limit)
+	}
+	query := listEmailsMysql
+	var queryParams []interface{}
+	if cursor != "" {
+		var key listEmailsKey
+		if err := decodeCursor(string(cursor), &key); err != nil {
+			return ListEmailsPage{}, err
+		}
+		query = strings.Replace(query, "/*PAGINATE:WHERE:email ASC 0*/", "WHERE email > ?", 1)
+		queryParams = append(queryParams, key.Email)
+	}
+	query += " LIMIT ?"
+	queryParams = append(queryParams, limit+1)
+	rows, err := q.db.QueryContext(ctx, query, queryParams...)
+	if err != nil {
+		return ListEmailsPage{}, err
+	}
+	defer rows.Close()
+	var page ListEmailsPage
+	for rows.Next() {
+		var email string
+		if err := rows.Scan(&email); err != nil {
+			return ListEmailsPage{}, err
+		}
+		page.Items = append(page.Items, email)
+	}
+	if err := rows.Close(); err != nil {
+		return ListEmailsPage{}, err
+	}
+	if err := rows.Err(); err != nil {
+		return ListEmailsPage{}, err
+	}
+	if len(page.Items) > limit {
+		page.Items = page.Items[:limit]
+		last := page.Items[limit-1]
+		next, err := encodeCursor(listEmailsKey{
+			Email: last,
+		})
+		if err != nil {
+			return ListEmailsPage{}, err
+		}
+		page.Next = ListEmailsCursor(next)
+	}
+	return page, nil
+}
+
+const listUsersMysql = `-- name: ListUsers :paginate
+SELECT id, name, created_at FROM users
+WHERE org_id = ? /*PAGINATE:AND:created_at DESC 2,id DESC 0*/
+ORDER BY created_at DESC, id DESC
+`
+
+// ListUsersPage is a page of ListUsers results.
+type ListUsersPage struct {
+	Items []ListUsersRow
+	// Next is the cursor of the following page, empty on the last page
+	Next ListUsersCursor
+}
+
+// ListUsersCursor is an opaque position in the results of
+// ListUsers. The empty cursor starts from the first row.
+type ListUsersCursor string
+
+type listUsersKey struct {
+	CreatedAt time.Time
+	ID        int64
+}
+
+func (q *MysqlAccess) ListUsers(ctx context.Context, orgID int64, cursor ListUsersCursor, limit int) (ListUsersPage, error) {
+	if limit < 1 {
+		return ListUsersPage{}, fmt.Errorf("limit must be at least 1; got %d", limit)
+	}
+	query := listUsersMysql
+	var queryParams []interface{}
+	queryParams = append(queryParams, orgID)
+	if cursor != "" {
+		var key listUsersKey
+		if err := decodeCursor(string(cursor), &key); err != nil {
+			return ListUsersPage{}, err
+		}
+		query = strings.Replace(query, "/*PAGINATE:AND:created_at DESC 2,id DESC 0*/", "AND (created_at, id) < (?, ?)", 1)
+		queryParams = append(queryParams, key.CreatedAt, key.ID)
+	}
+	query += " LIMIT ?"
+	queryParams = append(queryParams, limit+1)
+	rows, err := q.db.QueryContext(ctx, query, queryParams...)
+	if err != nil {
+		return ListUsersPage{}, err
+	}
+	defer rows.Close()
+	var page ListUsersPage
+	for rows.Next() {
+		var i ListUsersRow
+		if err := rows.Scan(&i.ID, &i.Name, &i.CreatedAt); err != nil {
+			return ListUsersPage{}, err
+		}
+		page.Items = append(page.Items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return ListUsersPage{}, err
+	}
+	if err := rows.Err(); err != nil {
+		return ListUsersPage{}, err
+	}
+	if len(page.Items) > limit {
+		page.Items = page.Items[:limit]
+		last := page.Items[limit-1]
+		next, err := encodeCursor(listUsersKey{
+			CreatedAt: last.CreatedAt,
+			ID:        last.ID,
+		})
+		if err != nil {
+			return ListUsersPage{}, err
+		}
+		page.Next = ListUsersCursor(next)
+	}
+	return page, nil
+}
+
+const listUsersByEmailMysql = `-- name: ListUsersByEmail :paginate
+SELECT id, name, email FROM users u
+WHERE (u.org_id = ? OR u.name = ?) /*PAGINATE:AND:u.name ASC 1,u.email DESC 2*/
+ORDER BY u.name, u.email DESC
+`
+
+// ListUsersByEmailPage is a page of ListUsersByEmail results.
+type ListUsersByEmailPage struct {
+	Items []ListUsersByEmailRow
+	// Next is the cursor of the following page, empty on the last page
+	Next ListUsersByEmailCursor
+}
+
+// ListUsersByEmailCursor is an opaque position in the results of
+// ListUsersByEmail. The empty cursor starts from the first row.
+type ListUsersByEmailCursor string
+
+type listUsersByEmailKey struct {
+	Name  string
+	Email string
+}
+
+func (q *MysqlAccess) ListUsersByEmail(ctx context.Context, arg ListUsersByEmailParams, cursor ListUsersByEmailCursor, limit int) (ListUsersByEmailPage, error) {
+	if limit < 1 {
+		return ListUsersByEmailPage{}, fmt.Errorf("limit must be at least 1; got %d", limit)
+	}
+	query := listUsersByEmailMysql
+	var queryParams []interface{}
+	queryParams = append(queryParams, arg.OrgID)
+	queryParams = append(queryParams, arg.Name)
+	if cursor != "" {
+		var key listUsersByEmailKey
+		if err := decodeCursor(string(cursor), &key); err != nil {
+			return ListUsersByEmailPage{}, err
+		}
+		query = strings.Replace(query, "/*PAGINATE:AND:u.name ASC 1,u.email DESC 2*/", "AND ((u.name > ?) OR (u.name = ? AND u.email < ?))", 1)
+		queryParams = append(queryParams, key.Name, key.Name, key.Email)
+	}
+	query += " LIMIT ?"
+	queryParams = append(queryParams, limit+1)
+	rows, err := q.db.QueryContext(ctx, query, queryParams...)
+	if err != nil {
+		return ListUsersByEmailPage{}, err
+	}
+	defer rows.Close()
+	var page ListUsersByEmailPage
+	for rows.Next() {
+		var i ListUsersByEmailRow
+		if err := rows.Scan(&i.ID, &i.Name, &i.Email); err != nil {
+			return ListUsersByEmailPage{}, err
+		}
+		page.Items = append(page.Items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return ListUsersByEmailPage{}, err
+	}
+	if err := rows.Err(); err != nil {
+		return ListUsersByEmailPage{}, err
+	}
+	if len(page.Items) > limit {
+		page.Items = page.Items[:limit]
+		last := page.Items[limit-1]
+		next, err := encodeCursor(listUsersByEmailKey{
+			Name:  last.Name,
+			Email: last.Email,
+		})
+		if err != nil {
+			return ListUsersByEmailPage{}, err
+		}
+		page.Next = ListUsersByEmailCursor(next)
+	}
+	return page, nil
+}
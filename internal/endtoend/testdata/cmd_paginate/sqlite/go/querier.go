@@ -0,0 +1,45 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.18.0
+
+package querytest
+
+import (
+	"database/sql"
+	"io"
+	"time"
+
+	"context"
+)
+
+type DBTX interface {
+	ExecContext(context.Context, string, ...interface{}) (sql.Result, error)
+	PrepareContext(context.Context, string) (*sql.Stmt, error)
+	QueryContext(context.Context, string, ...interface{}) (*sql.Rows, error)
+	QueryRowContext(context.Context, string, ...interface{}) *sql.Row
+}
+
+type DAL interface {
+	io.Closer
+	ListAllUsers(ctx context.Context, cursor ListAllUsersCursor, limit int) (ListAllUsersPage, error)
+	ListEmails(ctx context.Context, cursor ListEmailsCursor, limit int) (ListEmailsPage, error)
+	ListUsers(ctx context.Context, orgID int64, cursor ListUsersCursor, limit int) (ListUsersPage, error)
+	ListUsersByEmail(ctx context.Context, arg ListUsersByEmailParams, cursor ListUsersByEmailCursor, limit int) (ListUsersByEmailPage, error)
+}
+
+type ListUsersRow struct {
+	ID        int64
+	Name      string
+	CreatedAt time.Time
+}
+
+type ListUsersByEmailParams struct {
+	OrgID int64
+	Name  string
+}
+
+type ListUsersByEmailRow struct {
+	ID    int64
+	Name  string
+	Email string
+}
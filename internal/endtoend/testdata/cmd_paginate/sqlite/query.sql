@@ -0,0 +1,17 @@
+-- name: ListUsers :paginate
+SELECT id, name, created_at FROM users
+WHERE org_id = sqlc.arg(org_id)
+ORDER BY created_at DESC, id DESC;
+
+-- name: ListAllUsers :paginate
+SELECT id, org_id, name, email, created_at FROM users
+ORDER BY id;
+
+-- name: ListUsersByEmail :paginate
+SELECT id, name, email FROM users u
+WHERE u.org_id = sqlc.arg(org_id) OR u.name = sqlc.arg(name)
+ORDER BY u.name, u.email DESC;
+
+-- name: ListEmails :paginate
+SELECT email FROM users
+ORDER BY email;
@@ -0,0 +1,29 @@
+-- name: NotUnique :paginate
+SELECT id, name FROM users
+ORDER BY name;
+
+-- name: Nullable :paginate
+SELECT id, nickname FROM users
+ORDER BY nickname, id;
+
+-- name: NotInResult :paginate
+SELECT name FROM users
+ORDER BY id;
+
+-- name: Expression :paginate
+SELECT id, name FROM users
+ORDER BY LOWER(name), id;
+
+-- name: Limit :paginate
+SELECT id, name FROM users
+ORDER BY id
+LIMIT 10;
+
+-- name: NoOrder :paginate
+SELECT id, name FROM users;
+
+-- name: Update :paginate
+UPDATE users SET name = ?;
+
+-- name: JoinOneKey :paginate
+SELECT u.id, u.name, p.title FROM users u JOIN posts p ON p.user_id = u.id ORDER BY u.id;
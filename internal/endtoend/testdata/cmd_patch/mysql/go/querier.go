@@ -0,0 +1,38 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.18.0
+
+package querytest
+
+import (
+	"database/sql"
+	"io"
+
+	"context"
+)
+
+type DBTX interface {
+	ExecContext(context.Context, string, ...interface{}) (sql.Result, error)
+	PrepareContext(context.Context, string) (*sql.Stmt, error)
+	QueryContext(context.Context, string, ...interface{}) (*sql.Rows, error)
+	QueryRowContext(context.Context, string, ...interface{}) *sql.Row
+}
+
+type DAL interface {
+	io.Closer
+	PatchAge(ctx context.Context, age Patch[int32]) error
+	PatchOrgBio(ctx context.Context, arg PatchOrgBioParams) error
+	PatchUser(ctx context.Context, arg PatchUserParams) error
+}
+
+type PatchOrgBioParams struct {
+	Bio   Patch[string]
+	OrgID int64
+}
+
+type PatchUserParams struct {
+	Name Patch[string]
+	Bio  Patch[string]
+	Age  Patch[int32]
+	ID   int64
+}
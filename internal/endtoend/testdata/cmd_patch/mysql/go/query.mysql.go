@@ -0,0 +1,80 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.18.0
+// source: query.sql
+
+package querytest
+
+import (
+	"context"
+	"strings"
+)
+
+const patchAgeMysql = `-- name: PatchAge :patch
+UPDATE users SET /*PATCH:age*/age = ?
+`
+
+func (q *MysqlAccess) PatchAge(ctx context.Context, age Patch[int32]) error {
+	var set []string
+	var queryParams []interface{}
+	if age.Set {
+		set = append(set, "age = ?")
+		queryParams = append(queryParams, age.arg())
+	}
+	if len(set) == 0 {
+		return ErrEmptyPatch
+	}
+	query := strings.Replace(patchAgeMysql, "/*PATCH:age*/age = ?", strings.Join(set, ", "), 1)
+	_, err := q.db.ExecContext(ctx, query, queryParams...)
+	return err
+}
+
+const patchOrgBioMysql = `-- name: PatchOrgBio :patch
+UPDATE users SET org_id = org_id, /*PATCH:bio*/bio = ? WHERE org_id = ?
+`
+
+func (q *MysqlAccess) PatchOrgBio(ctx context.Context, arg PatchOrgBioParams) error {
+	var set []string
+	var queryParams []interface{}
+	if arg.Bio.Set {
+		set = append(set, "bio = ?")
+		queryParams = append(queryParams, arg.Bio.arg())
+	}
+	queryParams = append(queryParams, arg.OrgID)
+	if len(set) == 0 {
+		return ErrEmptyPatch
+	}
+	query := strings.Replace(patchOrgBioMysql, "/*PATCH:bio*/bio = ?", strings.Join(set, ", "), 1)
+	_, err := q.db.ExecContext(ctx, query, queryParams...)
+	return err
+}
+
+const patchUserMysql = `-- name: PatchUser :patch
+UPDATE users
+SET /*PATCH:name*/name = ?, /*PATCH:bio*/bio = ?, /*PATCH:age*/age = ?
+WHERE id = ?
+`
+
+func (q *MysqlAccess) PatchUser(ctx context.Context, arg PatchUserParams) error {
+	var set []string
+	var queryParams []interface{}
+	if arg.Name.Set {
+		set = append(set, "name = ?")
+		queryParams = append(queryParams, arg.Name.arg())
+	}
+	if arg.Bio.Set {
+		set = append(set, "bio = ?")
+		queryParams = append(queryParams, arg.Bio.arg())
+	}
+	if arg.Age.Set {
+		set = append(set, "age = ?")
+		queryParams = append(queryParams, arg.Age.arg())
+	}
+	queryParams = append(queryParams, arg.ID)
+	if len(set) == 0 {
+		return ErrEmptyPatch
+	}
+	query := strings.Replace(patchUserMysql, "/*PATCH:name*/name = ?, /*PATCH:bio*/bio = ?, /*PATCH:age*/age = ?", strings.Join(set, ", "), 1)
+	_, err := q.db.ExecContext(ctx, query, queryParams...)
+	return err
+}
@@ -0,0 +1,7 @@
+CREATE TABLE users (
+    id INTEGER PRIMARY KEY,
+    org_id INTEGER NOT NULL,
+    name TEXT NOT NULL,
+    bio TEXT,
+    age INTEGER NOT NULL
+);
@@ -0,0 +1,111 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.18.0
+
+package querytest
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+)
+
+func NewSqlite(db DBTX) *SqliteAccess {
+	return &SqliteAccess{db: db}
+}
+
+func PrepareSqlite(ctx context.Context, db DBTX) (*SqliteAccess, error) {
+	q := SqliteAccess{db: db}
+	var err error
+	if q.listUserIDsStmt, err = db.PrepareContext(ctx, listUserIDsSqlite); err != nil {
+		return nil, fmt.Errorf("error preparing query ListUserIDs: %w", err)
+	}
+	if q.patchAgeStmt, err = db.PrepareContext(ctx, patchAgeSqlite); err != nil {
+		return nil, fmt.Errorf("error preparing query PatchAge: %w", err)
+	}
+	if q.patchOrgBioStmt, err = db.PrepareContext(ctx, patchOrgBioSqlite); err != nil {
+		return nil, fmt.Errorf("error preparing query PatchOrgBio: %w", err)
+	}
+	if q.patchUserStmt, err = db.PrepareContext(ctx, patchUserSqlite); err != nil {
+		return nil, fmt.Errorf("error preparing query PatchUser: %w", err)
+	}
+	return &q, nil
+}
+
+func (q *SqliteAccess) Close() error {
+	var err error
+	if q.listUserIDsStmt != nil {
+		if cerr := q.listUserIDsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listUserIDsStmt: %w", cerr)
+		}
+	}
+	if q.patchAgeStmt != nil {
+		if cerr := q.patchAgeStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing patchAgeStmt: %w", cerr)
+		}
+	}
+	if q.patchOrgBioStmt != nil {
+		if cerr := q.patchOrgBioStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing patchOrgBioStmt: %w", cerr)
+		}
+	}
+	if q.patchUserStmt != nil {
+		if cerr := q.patchUserStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing patchUserStmt: %w", cerr)
+		}
+	}
+	return err
+}
+
+func (q *SqliteAccess) exec(ctx context.Context, stmt *sql.Stmt, query string, args ...interface{}) (sql.Result, error) {
+	switch {
+	case stmt != nil && q.tx != nil:
+		return q.tx.StmtContext(ctx, stmt).ExecContext(ctx, args...)
+	case stmt != nil:
+		return stmt.ExecContext(ctx, args...)
+	default:
+		return q.db.ExecContext(ctx, query, args...)
+	}
+}
+
+func (q *SqliteAccess) query(ctx context.Context, stmt *sql.Stmt, query string, args ...interface{}) (*sql.Rows, error) {
+	switch {
+	case stmt != nil && q.tx != nil:
+		return q.tx.StmtContext(ctx, stmt).QueryContext(ctx, args...)
+	case stmt != nil:
+		return stmt.QueryContext(ctx, args...)
+	default:
+		return q.db.QueryContext(ctx, query, args...)
+	}
+}
+
+func (q *SqliteAccess) queryRow(ctx context.Context, stmt *sql.Stmt, query string, args ...interface{}) *sql.Row {
+	switch {
+	case stmt != nil && q.tx != nil:
+		return q.tx.StmtContext(ctx, stmt).QueryRowContext(ctx, args...)
+	case stmt != nil:
+		return stmt.QueryRowContext(ctx, args...)
+	default:
+		return q.db.QueryRowContext(ctx, query, args...)
+	}
+}
+
+type SqliteAccess struct {
+	db              DBTX
+	tx              *sql.Tx
+	listUserIDsStmt *sql.Stmt
+	patchAgeStmt    *sql.Stmt
+	patchOrgBioStmt *sql.Stmt
+	patchUserStmt   *sql.Stmt
+}
+
+func (q *SqliteAccess) WithTx(tx *sql.Tx) *SqliteAccess {
+	return &SqliteAccess{
+		db:              tx,
+		tx:              tx,
+		listUserIDsStmt: q.listUserIDsStmt,
+		patchAgeStmt:    q.patchAgeStmt,
+		patchOrgBioStmt: q.patchOrgBioStmt,
+		patchUserStmt:   q.patchUserStmt,
+	}
+}
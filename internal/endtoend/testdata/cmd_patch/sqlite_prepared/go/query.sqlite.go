@@ -0,0 +1,117 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.18.0
+// source: query.sql
+
+package querytest
+
+import (
+	"context"
+	"strings"
+)
+
+const listUserIDsSqlite = `-- name: ListUserIDs :many
+SELECT id FROM users WHERE id IN (/*SLICE:ids*/?)
+`
+
+func (q *SqliteAccess) ListUserIDs(ctx context.Context, ids []int64) ([]int64, error) {
+	query := listUserIDsSqlite
+	var queryParams []interface{}
+	if len(ids) > 0 {
+		for _, v := range ids {
+			queryParams = append(queryParams, v)
+		}
+		query = strings.Replace(query, "/*SLICE:ids*/?", strings.Repeat(",?", len(ids))[1:], 1)
+	} else {
+		query = strings.Replace(query, "/*SLICE:ids*/?", "NULL", 1)
+	}
+	rows, err := q.query(ctx, nil, query, queryParams...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []int64
+	for rows.Next() {
+		var id int64
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		items = append(items, id)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const patchAgeSqlite = `-- name: PatchAge :patch
+UPDATE users SET /*PATCH:age*/age = ?
+`
+
+func (q *SqliteAccess) PatchAge(ctx context.Context, age Patch[int64]) error {
+	var set []string
+	var queryParams []interface{}
+	if age.Set {
+		set = append(set, "age = ?")
+		queryParams = append(queryParams, age.arg())
+	}
+	if len(set) == 0 {
+		return ErrEmptyPatch
+	}
+	query := strings.Replace(patchAgeSqlite, "/*PATCH:age*/age = ?", strings.Join(set, ", "), 1)
+	_, err := q.exec(ctx, nil, query, queryParams...)
+	return err
+}
+
+const patchOrgBioSqlite = `-- name: PatchOrgBio :patch
+UPDATE users SET org_id = org_id, /*PATCH:bio*/bio = ? WHERE org_id = ?
+`
+
+func (q *SqliteAccess) PatchOrgBio(ctx context.Context, arg PatchOrgBioParams) error {
+	var set []string
+	var queryParams []interface{}
+	if arg.Bio.Set {
+		set = append(set, "bio = ?")
+		queryParams = append(queryParams, arg.Bio.arg())
+	}
+	queryParams = append(queryParams, arg.OrgID)
+	if len(set) == 0 {
+		return ErrEmptyPatch
+	}
+	query := strings.Replace(patchOrgBioSqlite, "/*PATCH:bio*/bio = ?", strings.Join(set, ", "), 1)
+	_, err := q.exec(ctx, nil, query, queryParams...)
+	return err
+}
+
+const patchUserSqlite = `-- name: PatchUser :patch
+UPDATE users
+SET /*PATCH:name*/name = ?, /*PATCH:bio*/bio = ?, /*PATCH:age*/age = ?
+WHERE id = ?
+`
+
+func (q *SqliteAccess) PatchUser(ctx context.Context, arg PatchUserParams) error {
+	var set []string
+	var queryParams []interface{}
+	if arg.Name.Set {
+		set = append(set, "name = ?")
+		queryParams = append(queryParams, arg.Name.arg())
+	}
+	if arg.Bio.Set {
+		set = append(set, "bio = ?")
+		queryParams = append(queryParams, arg.Bio.arg())
+	}
+	if arg.Age.Set {
+		set = append(set, "age = ?")
+		queryParams = append(queryParams, arg.Age.arg())
+	}
+	queryParams = append(queryParams, arg.ID)
+	if len(set) == 0 {
+		return ErrEmptyPatch
+	}
+	query := strings.Replace(patchUserSqlite, "/*PATCH:name*/name = ?, /*PATCH:bio*/bio = ?, /*PATCH:age*/age = ?", strings.Join(set, ", "), 1)
+	_, err := q.exec(ctx, nil, query, queryParams...)
+	return err
+}
@@ -0,0 +1,13 @@
+-- name: PatchUser :patch
+UPDATE users
+SET name = sqlc.arg(name), bio = sqlc.arg(bio), age = sqlc.arg(age)
+WHERE id = sqlc.arg(id);
+
+-- name: PatchOrgBio :patch
+UPDATE users SET org_id = org_id, bio = ? WHERE org_id = ?;
+
+-- name: PatchAge :patch
+UPDATE users SET age = ?;
+
+-- name: ListUserIDs :many
+SELECT id FROM users WHERE id IN (sqlc.slice(ids));
@@ -0,0 +1,11 @@
+-- name: Select :patch
+SELECT id FROM users WHERE id = ?;
+
+-- name: NoParams :patch
+UPDATE users SET age = age + 1 WHERE id = ?;
+
+-- name: Reused :patch
+UPDATE users SET name = sqlc.arg(name) WHERE name = sqlc.arg(name);
+
+-- name: Apart :patch
+UPDATE users SET name = ?, age = age + 1, bio = ? WHERE id = ?;
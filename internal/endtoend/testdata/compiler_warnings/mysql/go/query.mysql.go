@@ -0,0 +1,21 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.18.0
+// source: query.sql
+
+package querytest
+
+import "context"
+
+const countAuthorsMysql = `-- name: CountAuthors :one
+WITH named_authors AS (SELECT id FROM authors WHERE name <> ''),
+     without_bio AS (SELECT id FROM authors WHERE bio IS NULL)
+SELECT count(*) FROM named_authors
+`
+
+func (q *MysqlAccess) CountAuthors(ctx context.Context) (int64, error) {
+	row := q.db.QueryRowContext(ctx, countAuthorsMysql)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
@@ -0,0 +1,4 @@
+-- name: CountAuthors :one
+WITH named_authors AS (SELECT id FROM authors WHERE name <> ''),
+     without_bio AS (SELECT id FROM authors WHERE bio IS NULL)
+SELECT count(*) FROM named_authors;
@@ -0,0 +1,85 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.18.0
+// source: query.sql
+
+package querytest
+
+import (
+	"context"
+	"database/sql"
+)
+
+const deleteAuthorSqlite = `-- name: DeleteAuthor :exec
+DELETE FROM authors WHERE id = ? RETURNING id
+`
+
+func (q *SqliteAccess) DeleteAuthor(ctx context.Context, id int64) error {
+	_, err := q.db.ExecContext(ctx, deleteAuthorSqlite, id)
+	return err
+}
+
+const findAuthorsSqlite = `-- name: FindAuthors :many
+SELECT id FROM authors WHERE name = ?1 OR bio = ?1
+`
+
+func (q *SqliteAccess) FindAuthors(ctx context.Context, name sql.NullString) ([]int64, error) {
+	rows, err := q.db.QueryContext(ctx, findAuthorsSqlite, name)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []int64
+	for rows.Next() {
+		var id int64
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		items = append(items, id)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getBioSqlite = `-- name: GetBio :one
+SELECT bio FROM authors WHERE id = ?
+`
+
+func (q *SqliteAccess) GetBio(ctx context.Context, id int64) (string, error) {
+	row := q.db.QueryRowContext(ctx, getBioSqlite, id)
+	var bio string
+	err := row.Scan(&bio)
+	return bio, err
+}
+
+const listAuthorsSqlite = `-- name: ListAuthors :many
+SELECT id, name, bio FROM authors
+`
+
+func (q *SqliteAccess) ListAuthors(ctx context.Context) ([]Author, error) {
+	rows, err := q.db.QueryContext(ctx, listAuthorsSqlite)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Author
+	for rows.Next() {
+		var i Author
+		if err := rows.Scan(&i.ID, &i.Name, &i.Bio); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
@@ -0,0 +1,11 @@
+-- name: ListAuthors :many
+SELECT * FROM authors;
+
+-- name: FindAuthors :many
+SELECT id FROM authors WHERE name = sqlc.arg(name) OR bio = sqlc.narg(name);
+
+-- name: DeleteAuthor :exec
+DELETE FROM authors WHERE id = ? RETURNING id;
+
+-- name: GetBio :one
+SELECT bio FROM authors WHERE id = ?;
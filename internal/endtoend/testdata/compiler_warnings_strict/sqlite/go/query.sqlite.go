@@ -0,0 +1,44 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.18.0
+// source: query.sql
+
+package querytest
+
+import "context"
+
+const deleteAuthorSqlite = `-- name: DeleteAuthor :exec
+DELETE FROM authors WHERE id = ? RETURNING id
+`
+
+func (q *SqliteAccess) DeleteAuthor(ctx context.Context, id int64) error {
+	_, err := q.db.ExecContext(ctx, deleteAuthorSqlite, id)
+	return err
+}
+
+const listAuthorsSqlite = `-- name: ListAuthors :many
+SELECT id, name, bio FROM authors
+`
+
+func (q *SqliteAccess) ListAuthors(ctx context.Context) ([]Author, error) {
+	rows, err := q.db.QueryContext(ctx, listAuthorsSqlite)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Author
+	for rows.Next() {
+		var i Author
+		if err := rows.Scan(&i.ID, &i.Name, &i.Bio); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
@@ -0,0 +1,5 @@
+-- name: ListAuthors :many
+SELECT id, name, bio FROM authors;
+
+-- name: DeleteAuthor :exec
+DELETE FROM authors WHERE id = ? RETURNING id;
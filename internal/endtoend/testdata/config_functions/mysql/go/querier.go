@@ -0,0 +1,36 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.18.0
+
+package querytest
+
+import (
+	"database/sql"
+	"io"
+
+	"context"
+)
+
+type DBTX interface {
+	ExecContext(context.Context, string, ...interface{}) (sql.Result, error)
+	PrepareContext(context.Context, string) (*sql.Stmt, error)
+	QueryContext(context.Context, string, ...interface{}) (*sql.Rows, error)
+	QueryRowContext(context.Context, string, ...interface{}) *sql.Row
+}
+
+type DAL interface {
+	io.Closer
+	Score(ctx context.Context, arg ScoreParams) (float64, error)
+	Slugs(ctx context.Context) ([]SlugsRow, error)
+}
+
+type ScoreParams struct {
+	Similarity string
+	ID         int64
+}
+
+type SlugsRow struct {
+	ID       int64
+	Slug     string
+	Reversed sql.NullString
+}
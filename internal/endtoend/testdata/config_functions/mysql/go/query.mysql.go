@@ -0,0 +1,46 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.18.0
+// source: query.sql
+
+package querytest
+
+import "context"
+
+const scoreMysql = `-- name: Score :one
+SELECT similarity(name, ?) AS score FROM authors WHERE id = ?
+`
+
+func (q *MysqlAccess) Score(ctx context.Context, arg ScoreParams) (float64, error) {
+	row := q.db.QueryRowContext(ctx, scoreMysql, arg.Similarity, arg.ID)
+	var score float64
+	err := row.Scan(&score)
+	return score, err
+}
+
+const slugsMysql = `-- name: Slugs :many
+SELECT id, slugify(name) AS slug, reverse_text(bio) AS reversed FROM authors
+`
+
+func (q *MysqlAccess) Slugs(ctx context.Context) ([]SlugsRow, error) {
+	rows, err := q.db.QueryContext(ctx, slugsMysql)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []SlugsRow
+	for rows.Next() {
+		var i SlugsRow
+		if err := rows.Scan(&i.ID, &i.Slug, &i.Reversed); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
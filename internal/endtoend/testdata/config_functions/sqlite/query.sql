@@ -0,0 +1,5 @@
+-- name: Slugs :many
+SELECT id, slugify(name) AS slug, reverse_text(bio) AS reversed FROM authors;
+
+-- name: Score :one
+SELECT similarity(name, ?) AS score FROM authors WHERE id = ?;
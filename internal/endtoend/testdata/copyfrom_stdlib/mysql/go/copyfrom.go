@@ -0,0 +1,226 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.18.0
+// source: copyfrom.go
+
+package querytest
+
+import (
+	"bufio"
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"io"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/go-sql-driver/mysql"
+)
+
+// copyFromMaxParams is the largest number of placeholders in a statement.
+const copyFromMaxParams = 65535
+
+// copyFromTx runs fn in a transaction, unless db can't start one because it
+// already is a transaction.
+func copyFromTx(ctx context.Context, db DBTX, fn func(DBTX) error) error {
+	beginner, ok := db.(interface {
+		BeginTx(context.Context, *sql.TxOptions) (*sql.Tx, error)
+	})
+	if !ok {
+		return fn(db)
+	}
+	tx, err := beginner.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	if err := fn(tx); err != nil {
+		tx.Rollback()
+		return err
+	}
+	return tx.Commit()
+}
+
+var loadDataSeq uint64
+
+var loadDataEscaper = strings.NewReplacer(`\`, `\\`, "\t", `\t`, "\n", `\n`, "\r", `\r`, "\x00", `\0`)
+
+// loadDataReader streams rows in the tab separated format LOAD DATA reads by
+// default. Closing the reader stops the stream.
+func loadDataReader(rows int, values func(int) []interface{}) *io.PipeReader {
+	r, w := io.Pipe()
+	go func() {
+		buf := bufio.NewWriter(w)
+		for i := 0; i < rows; i++ {
+			for j, v := range values(i) {
+				if j > 0 {
+					buf.WriteByte('\t')
+				}
+				if err := writeLoadDataValue(buf, v); err != nil {
+					w.CloseWithError(err)
+					return
+				}
+			}
+			buf.WriteByte('\n')
+		}
+		w.CloseWithError(buf.Flush())
+	}()
+	return r
+}
+
+func writeLoadDataValue(w *bufio.Writer, v interface{}) error {
+	v, err := driver.DefaultParameterConverter.ConvertValue(v)
+	if err != nil {
+		return err
+	}
+	var s string
+	switch v := v.(type) {
+	case nil:
+		_, err := w.WriteString(`\N`)
+		return err
+	case []byte:
+		s = string(v)
+	case string:
+		s = v
+	case bool:
+		s = "0"
+		if v {
+			s = "1"
+		}
+	case time.Time:
+		// The driver sends times in UTC unless its loc parameter is set
+		s = v.UTC().Format("2006-01-02 15:04:05.999999")
+	default:
+		s = fmt.Sprint(v)
+	}
+	_, err = loadDataEscaper.WriteString(w, s)
+	return err
+}
+
+// insertInsertAuthors inserts rows with as few statements as the
+// placeholder limit allows.
+func insertInsertAuthors(ctx context.Context, db DBTX, arg []InsertAuthorsParams) (int64, error) {
+	const chunkSize = copyFromMaxParams / 3
+	var total int64
+	for len(arg) > 0 {
+		chunk := arg
+		if len(chunk) > chunkSize {
+			chunk = chunk[:chunkSize]
+		}
+		arg = arg[len(chunk):]
+		queryParams := make([]interface{}, 0, len(chunk)*3)
+		for _, row := range chunk {
+			queryParams = append(queryParams, row.Name, row.Bio, row.Born)
+		}
+		query := "INSERT INTO authors (name, bio, born) VALUES " + strings.Repeat("(?, ?, ?), ", len(chunk)-1) + "(?, ?, ?)"
+		result, err := db.ExecContext(ctx, query, queryParams...)
+		if err != nil {
+			return total, err
+		}
+		n, err := result.RowsAffected()
+		if err != nil {
+			return total, err
+		}
+		total += n
+	}
+	return total, nil
+}
+
+func (q *MysqlAccess) InsertAuthors(ctx context.Context, arg []InsertAuthorsParams) (int64, error) {
+	return insertInsertAuthors(ctx, q.db, arg)
+}
+
+// insertInsertAuthorsTx inserts rows with as few statements as the
+// placeholder limit allows.
+func insertInsertAuthorsTx(ctx context.Context, db DBTX, arg []InsertAuthorsTxParams) (int64, error) {
+	const chunkSize = copyFromMaxParams / 3
+	var total int64
+	for len(arg) > 0 {
+		chunk := arg
+		if len(chunk) > chunkSize {
+			chunk = chunk[:chunkSize]
+		}
+		arg = arg[len(chunk):]
+		queryParams := make([]interface{}, 0, len(chunk)*3)
+		for _, row := range chunk {
+			queryParams = append(queryParams, row.Name, row.Bio, row.Born)
+		}
+		query := "INSERT INTO authors (name, bio, born) VALUES " + strings.Repeat("(?, ?, ?), ", len(chunk)-1) + "(?, ?, ?)"
+		result, err := db.ExecContext(ctx, query, queryParams...)
+		if err != nil {
+			return total, err
+		}
+		n, err := result.RowsAffected()
+		if err != nil {
+			return total, err
+		}
+		total += n
+	}
+	return total, nil
+}
+
+func (q *MysqlAccess) InsertAuthorsTx(ctx context.Context, arg []InsertAuthorsTxParams) (int64, error) {
+	var total int64
+	err := copyFromTx(ctx, q.db, func(tx DBTX) error {
+		var err error
+		total, err = insertInsertAuthorsTx(ctx, tx, arg)
+		return err
+	})
+	if err != nil {
+		return 0, err
+	}
+	return total, nil
+}
+
+// insertInsertTags inserts rows with as few statements as the
+// placeholder limit allows.
+func insertInsertTags(ctx context.Context, db DBTX, name []string) (int64, error) {
+	const chunkSize = copyFromMaxParams / 1
+	var total int64
+	for len(name) > 0 {
+		chunk := name
+		if len(chunk) > chunkSize {
+			chunk = chunk[:chunkSize]
+		}
+		name = name[len(chunk):]
+		queryParams := make([]interface{}, 0, len(chunk)*1)
+		for _, row := range chunk {
+			queryParams = append(queryParams, row)
+		}
+		query := "INSERT IGNORE INTO tags (name) VALUES " + strings.Repeat("(?), ", len(chunk)-1) + "(?)"
+		result, err := db.ExecContext(ctx, query, queryParams...)
+		if err != nil {
+			return total, err
+		}
+		n, err := result.RowsAffected()
+		if err != nil {
+			return total, err
+		}
+		total += n
+	}
+	return total, nil
+}
+
+func (q *MysqlAccess) InsertTags(ctx context.Context, name []string) (int64, error) {
+	return insertInsertTags(ctx, q.db, name)
+}
+
+func (q *MysqlAccess) LoadAuthors(ctx context.Context, arg []LoadAuthorsParams) (int64, error) {
+	name := fmt.Sprintf("sqlc_LoadAuthors_%d", atomic.AddUint64(&loadDataSeq, 1))
+	r := loadDataReader(len(arg), func(i int) []interface{} {
+		return []interface{}{
+			arg[i].Name,
+			arg[i].Bio,
+			arg[i].Born,
+		}
+	})
+	defer r.Close()
+	mysql.RegisterReaderHandler(name, func() io.Reader { return r })
+	defer mysql.DeregisterReaderHandler(name)
+	result, err := q.db.ExecContext(ctx, "LOAD DATA LOCAL INFILE 'Reader::"+name+"' INTO TABLE `authors` CHARACTER SET utf8mb4 (`name`, `bio`, `born`)")
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
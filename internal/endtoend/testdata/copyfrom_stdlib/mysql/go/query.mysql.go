@@ -0,0 +1,22 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.18.0
+// source: query.sql
+
+package querytest
+
+const insertAuthorsMysql = `-- name: InsertAuthors :copyfrom
+INSERT INTO authors (name, bio, born) VALUES (?, ?, ?)
+`
+
+const insertAuthorsTxMysql = `-- name: InsertAuthorsTx :copyfrom
+INSERT INTO authors (name, bio, born) VALUES (?, ?, ?)
+`
+
+const insertTagsMysql = `-- name: InsertTags :copyfrom
+INSERT IGNORE INTO tags (name) VALUES (?)
+`
+
+const loadAuthorsMysql = `-- name: LoadAuthors :copyfrom
+INSERT INTO authors (name, bio, born) VALUES (?, ?, ?)
+`
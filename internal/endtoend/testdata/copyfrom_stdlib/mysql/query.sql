@@ -0,0 +1,11 @@
+-- name: InsertAuthors :copyfrom
+INSERT INTO authors (name, bio, born) VALUES (?, ?, ?);
+
+-- name: InsertAuthorsTx :copyfrom tx=true
+INSERT INTO authors (name, bio, born) VALUES (?, ?, ?);
+
+-- name: LoadAuthors :copyfrom method=load_data
+INSERT INTO authors (name, bio, born) VALUES (?, ?, ?);
+
+-- name: InsertTags :copyfrom
+INSERT IGNORE INTO tags (name) VALUES (?);
@@ -0,0 +1,10 @@
+CREATE TABLE authors (
+  id   BIGINT PRIMARY KEY AUTO_INCREMENT,
+  name TEXT   NOT NULL,
+  bio  TEXT,
+  born DATETIME
+);
+
+CREATE TABLE tags (
+  name VARCHAR(255) NOT NULL UNIQUE
+);
@@ -0,0 +1,143 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.18.0
+// source: copyfrom.go
+
+package querytest
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+)
+
+// copyFromMaxParams is the largest number of placeholders in a statement.
+const copyFromMaxParams = 32766
+
+// copyFromTx runs fn in a transaction, unless db can't start one because it
+// already is a transaction.
+func copyFromTx(ctx context.Context, db DBTX, fn func(DBTX) error) error {
+	beginner, ok := db.(interface {
+		BeginTx(context.Context, *sql.TxOptions) (*sql.Tx, error)
+	})
+	if !ok {
+		return fn(db)
+	}
+	tx, err := beginner.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	if err := fn(tx); err != nil {
+		tx.Rollback()
+		return err
+	}
+	return tx.Commit()
+}
+
+// insertInsertAuthors inserts rows with as few statements as the
+// placeholder limit allows.
+func insertInsertAuthors(ctx context.Context, db DBTX, arg []InsertAuthorsParams) (int64, error) {
+	const chunkSize = copyFromMaxParams / 2
+	var total int64
+	for len(arg) > 0 {
+		chunk := arg
+		if len(chunk) > chunkSize {
+			chunk = chunk[:chunkSize]
+		}
+		arg = arg[len(chunk):]
+		queryParams := make([]interface{}, 0, len(chunk)*2)
+		for _, row := range chunk {
+			queryParams = append(queryParams, row.Name, row.Bio)
+		}
+		query := "INSERT INTO authors (name, bio) VALUES " + strings.Repeat("(?, ?), ", len(chunk)-1) + "(?, ?)"
+		result, err := db.ExecContext(ctx, query, queryParams...)
+		if err != nil {
+			return total, err
+		}
+		n, err := result.RowsAffected()
+		if err != nil {
+			return total, err
+		}
+		total += n
+	}
+	return total, nil
+}
+
+func (q *SqliteAccess) InsertAuthors(ctx context.Context, arg []InsertAuthorsParams) (int64, error) {
+	return insertInsertAuthors(ctx, q.db, arg)
+}
+
+// insertInsertAuthorsNamed inserts rows with as few statements as the
+// placeholder limit allows.
+func insertInsertAuthorsNamed(ctx context.Context, db DBTX, arg []InsertAuthorsNamedParams) (int64, error) {
+	const chunkSize = copyFromMaxParams / 2
+	var total int64
+	for len(arg) > 0 {
+		chunk := arg
+		if len(chunk) > chunkSize {
+			chunk = chunk[:chunkSize]
+		}
+		arg = arg[len(chunk):]
+		queryParams := make([]interface{}, 0, len(chunk)*2)
+		for _, row := range chunk {
+			queryParams = append(queryParams, row.Name, row.Bio)
+		}
+		query := "INSERT INTO authors (name, bio) VALUES " + strings.Repeat("(?, ?), ", len(chunk)-1) + "(?, ?)"
+		result, err := db.ExecContext(ctx, query, queryParams...)
+		if err != nil {
+			return total, err
+		}
+		n, err := result.RowsAffected()
+		if err != nil {
+			return total, err
+		}
+		total += n
+	}
+	return total, nil
+}
+
+func (q *SqliteAccess) InsertAuthorsNamed(ctx context.Context, arg []InsertAuthorsNamedParams) (int64, error) {
+	var total int64
+	err := copyFromTx(ctx, q.db, func(tx DBTX) error {
+		var err error
+		total, err = insertInsertAuthorsNamed(ctx, tx, arg)
+		return err
+	})
+	if err != nil {
+		return 0, err
+	}
+	return total, nil
+}
+
+// insertInsertTags inserts rows with as few statements as the
+// placeholder limit allows.
+func insertInsertTags(ctx context.Context, db DBTX, name []string) (int64, error) {
+	const chunkSize = copyFromMaxParams / 1
+	var total int64
+	for len(name) > 0 {
+		chunk := name
+		if len(chunk) > chunkSize {
+			chunk = chunk[:chunkSize]
+		}
+		name = name[len(chunk):]
+		queryParams := make([]interface{}, 0, len(chunk)*1)
+		for _, row := range chunk {
+			queryParams = append(queryParams, row)
+		}
+		query := "INSERT INTO tags (name) VALUES " + strings.Repeat("(?), ", len(chunk)-1) + "(?) ON CONFLICT DO NOTHING"
+		result, err := db.ExecContext(ctx, query, queryParams...)
+		if err != nil {
+			return total, err
+		}
+		n, err := result.RowsAffected()
+		if err != nil {
+			return total, err
+		}
+		total += n
+	}
+	return total, nil
+}
+
+func (q *SqliteAccess) InsertTags(ctx context.Context, name []string) (int64, error) {
+	return insertInsertTags(ctx, q.db, name)
+}
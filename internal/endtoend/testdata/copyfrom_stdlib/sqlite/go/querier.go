@@ -0,0 +1,36 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.18.0
+
+package querytest
+
+import (
+	"database/sql"
+	"io"
+
+	"context"
+)
+
+type DBTX interface {
+	ExecContext(context.Context, string, ...interface{}) (sql.Result, error)
+	PrepareContext(context.Context, string) (*sql.Stmt, error)
+	QueryContext(context.Context, string, ...interface{}) (*sql.Rows, error)
+	QueryRowContext(context.Context, string, ...interface{}) *sql.Row
+}
+
+type DAL interface {
+	io.Closer
+	InsertAuthors(ctx context.Context, arg []InsertAuthorsParams) (int64, error)
+	InsertAuthorsNamed(ctx context.Context, arg []InsertAuthorsNamedParams) (int64, error)
+	InsertTags(ctx context.Context, name []string) (int64, error)
+}
+
+type InsertAuthorsParams struct {
+	Name string
+	Bio  sql.NullString
+}
+
+type InsertAuthorsNamedParams struct {
+	Name string
+	Bio  sql.NullString
+}
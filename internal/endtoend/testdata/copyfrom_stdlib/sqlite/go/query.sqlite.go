@@ -0,0 +1,18 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.18.0
+// source: query.sql
+
+package querytest
+
+const insertAuthorsSqlite = `-- name: InsertAuthors :copyfrom
+INSERT INTO authors (name, bio) VALUES (?, ?)
+`
+
+const insertAuthorsNamedSqlite = `-- name: InsertAuthorsNamed :copyfrom
+INSERT INTO authors (name, bio) VALUES (?1, ?2)
+`
+
+const insertTagsSqlite = `-- name: InsertTags :copyfrom
+INSERT INTO tags (name) VALUES (?) ON CONFLICT DO NOTHING
+`
@@ -0,0 +1,8 @@
+-- name: InsertAuthors :copyfrom
+INSERT INTO authors (name, bio) VALUES (?, ?);
+
+-- name: InsertAuthorsNamed :copyfrom tx=true
+INSERT INTO authors (name, bio) VALUES (sqlc.arg(name), sqlc.arg(bio));
+
+-- name: InsertTags :copyfrom
+INSERT INTO tags (name) VALUES (?) ON CONFLICT DO NOTHING;
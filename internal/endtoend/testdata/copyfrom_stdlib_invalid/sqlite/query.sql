@@ -0,0 +1,2 @@
+-- name: LoadAuthors :copyfrom method=load_data
+INSERT INTO authors (name, bio) VALUES (?, ?);
@@ -0,0 +1,9 @@
+CREATE TABLE authors (
+  id   INTEGER PRIMARY KEY,
+  name TEXT    NOT NULL,
+  bio  TEXT
+);
+
+CREATE TABLE tags (
+  name TEXT NOT NULL UNIQUE
+);
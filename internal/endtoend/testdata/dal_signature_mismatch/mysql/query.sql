@@ -0,0 +1,14 @@
+-- name: GetAuthor :one
+SELECT id, name, bio FROM authors WHERE id = ?;
+
+-- name: ListAuthors :many
+SELECT id, name FROM authors;
+
+-- name: DeleteAuthor :exec
+DELETE FROM authors WHERE id = ?;
+
+-- name: CountAuthors :one
+SELECT count(*) FROM authors;
+
+-- name: RenameAuthor :exec
+UPDATE authors SET name = ? WHERE id = ?;
@@ -0,0 +1,14 @@
+-- name: GetAuthor :one
+SELECT id, name, bio FROM authors WHERE id = ?;
+
+-- name: ListAuthors :many
+SELECT id, name FROM authors;
+
+-- name: DeleteAuthor :execrows
+DELETE FROM authors WHERE id = ?;
+
+-- name: RenameAuthor :exec
+UPDATE authors SET name = ? WHERE name = ?;
+
+-- name: SearchAuthors :many
+SELECT id, name FROM authors WHERE name LIKE ?;
@@ -0,0 +1,20 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.18.0
+// source: query.sql
+
+package querytest
+
+import "context"
+
+const getEditionMysql = `-- name: GetEdition :one
+SELECT id, title FROM editions
+WHERE id = ?
+`
+
+func (q *MysqlAccess) GetEdition(ctx context.Context, id int32) (Edition, error) {
+	row := q.db.QueryRowContext(ctx, getEditionMysql, id)
+	var i Edition
+	err := row.Scan(&i.ID, &i.Title)
+	return i, err
+}
@@ -0,0 +1,3 @@
+-- name: GetEdition :one
+SELECT id, title FROM editions
+WHERE id = ?;
@@ -0,0 +1,8 @@
+-- Index names are scoped to their table
+CREATE TABLE authors (id INT PRIMARY KEY, name TEXT NOT NULL, KEY idx (name));
+CREATE TABLE books (id INT PRIMARY KEY, title TEXT NOT NULL, KEY idx (title));
+ALTER TABLE books DROP INDEX idx;
+
+-- Unnamed indexes on the same column are named title, title_2, ...
+CREATE TABLE editions (id INT PRIMARY KEY, title TEXT NOT NULL, KEY (title), KEY (title));
+ALTER TABLE editions DROP INDEX title_2;
@@ -0,0 +1,5 @@
+CREATE TABLE editions (id INT PRIMARY KEY, title TEXT NOT NULL);
+
+CREATE TABLE authors (id INT PRIMARY KEY, name TEXT NOT NULL, KEY idx (name), KEY idx (id));
+
+ALTER TABLE editions DROP INDEX title;
@@ -0,0 +1,20 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.18.0
+
+package querytest
+
+import (
+	"fmt"
+)
+
+// NewDAL returns the DAL of an engine, one of "mysql", "sqlite".
+func NewDAL(engine string, db DBTX) (DAL, error) {
+	switch engine {
+	case "mysql":
+		return NewMysql(db), nil
+	case "sqlite":
+		return NewSqlite(db), nil
+	}
+	return nil, fmt.Errorf("unknown engine %q", engine)
+}
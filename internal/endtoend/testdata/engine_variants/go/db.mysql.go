@@ -0,0 +1,29 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.18.0
+
+package querytest
+
+import (
+	"database/sql"
+)
+
+func NewMysql(db DBTX) *MysqlAccess {
+	return &MysqlAccess{db: db}
+}
+
+type MysqlAccess struct {
+	db DBTX
+}
+
+// Close lets MysqlAccess implement DAL. It has no prepared statements to
+// close.
+func (q *MysqlAccess) Close() error {
+	return nil
+}
+
+func (q *MysqlAccess) WithTx(tx *sql.Tx) *MysqlAccess {
+	return &MysqlAccess{
+		db: tx,
+	}
+}
@@ -0,0 +1,32 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.18.0
+
+package querytest
+
+import (
+	"database/sql"
+	"io"
+
+	"context"
+)
+
+type DBTX interface {
+	ExecContext(context.Context, string, ...interface{}) (sql.Result, error)
+	PrepareContext(context.Context, string) (*sql.Stmt, error)
+	QueryContext(context.Context, string, ...interface{}) (*sql.Rows, error)
+	QueryRowContext(context.Context, string, ...interface{}) *sql.Row
+}
+
+type DAL interface {
+	io.Closer
+	CountAuthorsWithBio(ctx context.Context) (int64, error)
+	GetAuthor(ctx context.Context, id int64) (Author, error)
+	// UpsertAuthor sets the bio of an author, adding the author if needed.
+	UpsertAuthor(ctx context.Context, arg UpsertAuthorParams) error
+}
+
+type UpsertAuthorParams struct {
+	Name string
+	Bio  sql.NullString
+}
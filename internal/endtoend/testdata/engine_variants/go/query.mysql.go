@@ -0,0 +1,43 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.18.0
+// source: query.sql
+
+package querytest
+
+import "context"
+
+const countAuthorsWithBioMysql = `-- name: CountAuthorsWithBio :one
+SELECT COUNT(*) FROM authors
+WHERE bio IS NOT NULL AND CHAR_LENGTH(bio) > 0
+`
+
+func (q *MysqlAccess) CountAuthorsWithBio(ctx context.Context) (int64, error) {
+	row := q.db.QueryRowContext(ctx, countAuthorsWithBioMysql)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const getAuthorMysql = `-- name: GetAuthor :one
+SELECT id, name, bio FROM authors
+WHERE id = ?
+`
+
+func (q *MysqlAccess) GetAuthor(ctx context.Context, id int64) (Author, error) {
+	row := q.db.QueryRowContext(ctx, getAuthorMysql, id)
+	var i Author
+	err := row.Scan(&i.ID, &i.Name, &i.Bio)
+	return i, err
+}
+
+const upsertAuthorMysql = `-- name: UpsertAuthor :exec
+INSERT INTO authors (name, bio) VALUES (?, ?)
+ON DUPLICATE KEY UPDATE bio = VALUES(bio)
+`
+
+// UpsertAuthor sets the bio of an author, adding the author if needed.
+func (q *MysqlAccess) UpsertAuthor(ctx context.Context, arg UpsertAuthorParams) error {
+	_, err := q.db.ExecContext(ctx, upsertAuthorMysql, arg.Name, arg.Bio)
+	return err
+}
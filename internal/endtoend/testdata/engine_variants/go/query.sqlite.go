@@ -0,0 +1,43 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.18.0
+// source: query.sql
+
+package querytest
+
+import "context"
+
+const countAuthorsWithBioSqlite = `-- name: CountAuthorsWithBio :one
+SELECT COUNT(*) FROM authors
+WHERE bio IS NOT NULL AND length(bio) > 0
+`
+
+func (q *SqliteAccess) CountAuthorsWithBio(ctx context.Context) (int64, error) {
+	row := q.db.QueryRowContext(ctx, countAuthorsWithBioSqlite)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const getAuthorSqlite = `-- name: GetAuthor :one
+SELECT id, name, bio FROM authors
+WHERE id = ?1
+`
+
+func (q *SqliteAccess) GetAuthor(ctx context.Context, id int64) (Author, error) {
+	row := q.db.QueryRowContext(ctx, getAuthorSqlite, id)
+	var i Author
+	err := row.Scan(&i.ID, &i.Name, &i.Bio)
+	return i, err
+}
+
+const upsertAuthorSqlite = `-- name: UpsertAuthor :exec
+INSERT INTO authors (name, bio) VALUES (?1, ?2)
+ON CONFLICT (name) DO UPDATE SET bio = excluded.bio
+`
+
+// UpsertAuthor sets the bio of an author, adding the author if needed.
+func (q *SqliteAccess) UpsertAuthor(ctx context.Context, arg UpsertAuthorParams) error {
+	_, err := q.db.ExecContext(ctx, upsertAuthorSqlite, arg.Name, arg.Bio)
+	return err
+}
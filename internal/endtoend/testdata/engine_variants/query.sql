@@ -0,0 +1,18 @@
+-- name: GetAuthor :one
+SELECT id, name, bio FROM authors
+WHERE id = sqlc.arg(id);
+
+-- name: UpsertAuthor :exec
+-- UpsertAuthor sets the bio of an author, adding the author if needed.
+INSERT INTO authors (name, bio) VALUES (sqlc.arg(name), sqlc.arg(bio))
+ON DUPLICATE KEY UPDATE bio = VALUES(bio);
+-- engine: sqlite
+INSERT INTO authors (name, bio) VALUES (sqlc.arg(name), sqlc.arg(bio))
+ON CONFLICT (name) DO UPDATE SET bio = excluded.bio;
+
+-- name: CountAuthorsWithBio :one
+SELECT COUNT(*) FROM authors
+WHERE bio IS NOT NULL AND CHAR_LENGTH(bio) > 0;
+-- engine: sqlite
+SELECT COUNT(*) FROM authors
+WHERE bio IS NOT NULL AND length(bio) > 0;
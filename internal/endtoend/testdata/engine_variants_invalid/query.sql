@@ -0,0 +1,6 @@
+-- name: GetAuthor :one
+SELECT id, name, bio FROM authors
+WHERE id = sqlc.arg(id);
+-- engine: sqlite, oracle
+SELECT id, name, bio FROM authors
+WHERE id = sqlc.arg(id);
@@ -0,0 +1,5 @@
+CREATE TABLE authors (
+    id INTEGER NOT NULL PRIMARY KEY AUTOINCREMENT,
+    name TEXT NOT NULL UNIQUE,
+    bio TEXT
+);
@@ -0,0 +1,5 @@
+CREATE TABLE authors (
+    id BIGINT NOT NULL AUTO_INCREMENT PRIMARY KEY,
+    name VARCHAR(255) NOT NULL UNIQUE,
+    bio TEXT
+);
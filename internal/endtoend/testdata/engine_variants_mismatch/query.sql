@@ -0,0 +1,13 @@
+-- name: SearchAuthors :many
+SELECT id, name, bio FROM authors
+WHERE name LIKE CONCAT('%', sqlc.arg(pattern), '%');
+-- engine: sqlite
+SELECT id, name, bio FROM authors
+WHERE name LIKE '%' || sqlc.arg(pattern) || '%';
+
+-- name: GetBio :one
+SELECT bio FROM authors
+WHERE id = sqlc.arg(id);
+-- engine: sqlite
+SELECT COALESCE(bio, '') AS bio FROM authors
+WHERE id = sqlc.arg(id);
@@ -0,0 +1,145 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.18.0
+
+package querytest
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+)
+
+func NewMysql(db DBTX) *MysqlAccess {
+	return &MysqlAccess{db: db}
+}
+
+func PrepareMysql(ctx context.Context, db DBTX) (*MysqlAccess, error) {
+	q := MysqlAccess{db: db}
+	var err error
+	if q.createAuthorStmt, err = db.PrepareContext(ctx, createAuthorMysql); err != nil {
+		return nil, fmt.Errorf("error preparing query CreateAuthor: %w", err)
+	}
+	if q.getAuthorStmt, err = db.PrepareContext(ctx, getAuthorMysql); err != nil {
+		return nil, fmt.Errorf("error preparing query GetAuthor: %w", err)
+	}
+	if q.listAuthorsStmt, err = db.PrepareContext(ctx, listAuthorsMysql); err != nil {
+		return nil, fmt.Errorf("error preparing query ListAuthors: %w", err)
+	}
+	return &q, nil
+}
+
+func (q *MysqlAccess) Close() error {
+	var err error
+	if q.createAuthorStmt != nil {
+		if cerr := q.createAuthorStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing createAuthorStmt: %w", cerr)
+		}
+	}
+	if q.getAuthorStmt != nil {
+		if cerr := q.getAuthorStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getAuthorStmt: %w", cerr)
+		}
+	}
+	if q.listAuthorsStmt != nil {
+		if cerr := q.listAuthorsStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing listAuthorsStmt: %w", cerr)
+		}
+	}
+	return err
+}
+
+func (q *MysqlAccess) exec(ctx context.Context, stmt *sql.Stmt, query string, args ...interface{}) (sql.Result, error) {
+	switch {
+	case stmt != nil && q.tx != nil:
+		return q.tx.StmtContext(ctx, stmt).ExecContext(ctx, args...)
+	case stmt != nil:
+		return stmt.ExecContext(ctx, args...)
+	default:
+		return q.db.ExecContext(ctx, query, args...)
+	}
+}
+
+func (q *MysqlAccess) query(ctx context.Context, stmt *sql.Stmt, query string, args ...interface{}) (*sql.Rows, error) {
+	switch {
+	case stmt != nil && q.tx != nil:
+		return q.tx.StmtContext(ctx, stmt).QueryContext(ctx, args...)
+	case stmt != nil:
+		return stmt.QueryContext(ctx, args...)
+	default:
+		return q.db.QueryContext(ctx, query, args...)
+	}
+}
+
+func (q *MysqlAccess) queryRow(ctx context.Context, stmt *sql.Stmt, query string, args ...interface{}) *sql.Row {
+	switch {
+	case stmt != nil && q.tx != nil:
+		return q.tx.StmtContext(ctx, stmt).QueryRowContext(ctx, args...)
+	case stmt != nil:
+		return stmt.QueryRowContext(ctx, args...)
+	default:
+		return q.db.QueryRowContext(ctx, query, args...)
+	}
+}
+
+type MysqlAccess struct {
+	db               DBTX
+	tx               *sql.Tx
+	createAuthorStmt *sql.Stmt
+	getAuthorStmt    *sql.Stmt
+	listAuthorsStmt  *sql.Stmt
+	hook             Hook
+}
+
+func (q *MysqlAccess) WithTx(tx *sql.Tx) *MysqlAccess {
+	return &MysqlAccess{
+		db:               tx,
+		hook:             q.hook,
+		tx:               tx,
+		createAuthorStmt: q.createAuthorStmt,
+		getAuthorStmt:    q.getAuthorStmt,
+		listAuthorsStmt:  q.listAuthorsStmt,
+	}
+}
+
+// QueryInfo describes the query a Hook is called for.
+type QueryInfo struct {
+	// Name is the name of the query, such as GetAuthor
+	Name string
+	// Command is the command of the query, such as :one
+	Command string
+	// SQL is the query as written in its file, before sqlc.slice, sqlc.optional
+	// and sqlc.sort are filled in
+	SQL string
+	// File is the name of the query file
+	File string
+}
+
+// Hook is called around every query, for tracing, metrics or logging. Before
+// is called first, and the query runs with the context it returns. After is
+// called with that context once the query is done, and with its error. Queries
+// returning rows are done once the rows are read.
+type Hook interface {
+	Before(ctx context.Context, info QueryInfo) context.Context
+	After(ctx context.Context, info QueryInfo, err error)
+}
+
+// WithHook returns a copy of q calling hook around every query.
+func (q *MysqlAccess) WithHook(hook Hook) *MysqlAccess {
+	c := *q
+	c.hook = hook
+	return &c
+}
+
+func (q *MysqlAccess) hookBefore(ctx context.Context, info QueryInfo) context.Context {
+	if q.hook == nil {
+		return ctx
+	}
+	return q.hook.Before(ctx, info)
+}
+
+func (q *MysqlAccess) hookAfter(ctx context.Context, info QueryInfo, err error) {
+	if q.hook != nil {
+		q.hook.After(ctx, info, err)
+	}
+}
@@ -0,0 +1,105 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.18.0
+// source: query.sql
+
+package querytest
+
+import "context"
+
+const createAuthorMysql = `-- name: CreateAuthor :execlastid
+INSERT INTO authors (name, bio) VALUES (?, ?)
+`
+
+var createAuthorMysqlInfo = QueryInfo{
+	Name:    "CreateAuthor",
+	Command: ":execlastid",
+	SQL:     createAuthorMysql,
+	File:    "query.sql",
+}
+
+func (q *MysqlAccess) CreateAuthor(ctx context.Context, arg CreateAuthorParams) (_ int64, err error) {
+	ctx = q.hookBefore(ctx, createAuthorMysqlInfo)
+	defer func() {
+		q.hookAfter(ctx, createAuthorMysqlInfo, err)
+	}()
+	return q.runCreateAuthor(ctx, arg)
+}
+
+func (q *MysqlAccess) runCreateAuthor(ctx context.Context, arg CreateAuthorParams) (int64, error) {
+	result, err := q.exec(ctx, q.createAuthorStmt, createAuthorMysql, arg.Name, arg.Bio)
+	if err != nil {
+		return 0, err
+	}
+	return result.LastInsertId()
+}
+
+const getAuthorMysql = `-- name: GetAuthor :one
+SELECT id, name, bio FROM authors
+WHERE id = ?
+`
+
+var getAuthorMysqlInfo = QueryInfo{
+	Name:    "GetAuthor",
+	Command: ":one",
+	SQL:     getAuthorMysql,
+	File:    "query.sql",
+}
+
+func (q *MysqlAccess) GetAuthor(ctx context.Context, id int64) (_ Author, err error) {
+	ctx = q.hookBefore(ctx, getAuthorMysqlInfo)
+	defer func() {
+		q.hookAfter(ctx, getAuthorMysqlInfo, err)
+	}()
+	return q.runGetAuthor(ctx, id)
+}
+
+func (q *MysqlAccess) runGetAuthor(ctx context.Context, id int64) (Author, error) {
+	row := q.queryRow(ctx, q.getAuthorStmt, getAuthorMysql, id)
+	var i Author
+	err := row.Scan(&i.ID, &i.Name, &i.Bio)
+	return i, err
+}
+
+const listAuthorsMysql = `-- name: ListAuthors :many
+SELECT id, name, bio FROM authors
+ORDER BY name
+`
+
+var listAuthorsMysqlInfo = QueryInfo{
+	Name:    "ListAuthors",
+	Command: ":many",
+	SQL:     listAuthorsMysql,
+	File:    "query.sql",
+}
+
+func (q *MysqlAccess) ListAuthors(ctx context.Context) (_ []Author, err error) {
+	ctx = q.hookBefore(ctx, listAuthorsMysqlInfo)
+	defer func() {
+		q.hookAfter(ctx, listAuthorsMysqlInfo, err)
+	}()
+	return q.runListAuthors(ctx)
+}
+
+func (q *MysqlAccess) runListAuthors(ctx context.Context) ([]Author, error) {
+	rows, err := q.query(ctx, q.listAuthorsStmt, listAuthorsMysql)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Author
+	for rows.Next() {
+		var i Author
+		if err := rows.Scan(&i.ID, &i.Name, &i.Bio); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
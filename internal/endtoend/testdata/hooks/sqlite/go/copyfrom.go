@@ -0,0 +1,55 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.18.0
+// source: copyfrom.go
+
+package querytest
+
+import (
+	"context"
+	"strings"
+)
+
+// copyFromMaxParams is the largest number of placeholders in a statement.
+const copyFromMaxParams = 32766
+
+// insertCreateAuthors inserts rows with as few statements as the
+// placeholder limit allows.
+func insertCreateAuthors(ctx context.Context, db DBTX, arg []CreateAuthorsParams) (int64, error) {
+	const chunkSize = copyFromMaxParams / 2
+	var total int64
+	for len(arg) > 0 {
+		chunk := arg
+		if len(chunk) > chunkSize {
+			chunk = chunk[:chunkSize]
+		}
+		arg = arg[len(chunk):]
+		queryParams := make([]interface{}, 0, len(chunk)*2)
+		for _, row := range chunk {
+			queryParams = append(queryParams, row.Name, row.Bio)
+		}
+		query := "INSERT INTO authors (name, bio) VALUES " + strings.Repeat("(?, ?), ", len(chunk)-1) + "(?, ?)"
+		result, err := db.ExecContext(ctx, query, queryParams...)
+		if err != nil {
+			return total, err
+		}
+		n, err := result.RowsAffected()
+		if err != nil {
+			return total, err
+		}
+		total += n
+	}
+	return total, nil
+}
+
+func (q *SqliteAccess) CreateAuthors(ctx context.Context, arg []CreateAuthorsParams) (_ int64, err error) {
+	ctx = q.hookBefore(ctx, createAuthorsSqliteInfo)
+	defer func() {
+		q.hookAfter(ctx, createAuthorsSqliteInfo, err)
+	}()
+	return q.runCreateAuthors(ctx, arg)
+}
+
+func (q *SqliteAccess) runCreateAuthors(ctx context.Context, arg []CreateAuthorsParams) (int64, error) {
+	return insertCreateAuthors(ctx, q.db, arg)
+}
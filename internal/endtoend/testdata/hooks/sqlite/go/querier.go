@@ -0,0 +1,70 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.18.0
+
+package querytest
+
+import (
+	"database/sql"
+	"io"
+
+	"context"
+)
+
+type DBTX interface {
+	ExecContext(context.Context, string, ...interface{}) (sql.Result, error)
+	PrepareContext(context.Context, string) (*sql.Stmt, error)
+	QueryContext(context.Context, string, ...interface{}) (*sql.Rows, error)
+	QueryRowContext(context.Context, string, ...interface{}) *sql.Row
+}
+
+type DAL interface {
+	io.Closer
+	AuthorNames(ctx context.Context) (map[int64]AuthorNamesRow, error)
+	CreateAuthor(ctx context.Context, arg CreateAuthorParams) (int64, error)
+	CreateAuthors(ctx context.Context, arg []CreateAuthorsParams) (int64, error)
+	DeleteAuthors(ctx context.Context, name string) (int64, error)
+	EachAuthor(ctx context.Context, name string, fn func(Author) error) error
+	FindAuthor(ctx context.Context, name string) (Author, bool, error)
+	GetAuthor(ctx context.Context, id int64) (Author, error)
+	GetAuthorsByID(ctx context.Context, id []int64) *GetAuthorsByIDBatchResults
+	ListAuthors(ctx context.Context, sortBy ListAuthorsSort, sortDir SortDirection) ([]Author, error)
+	ListAuthorsByIDs(ctx context.Context, ids []int64) ([]Author, error)
+	ListAuthorsByName(ctx context.Context, name []string) *ListAuthorsByNameBatchResults
+	PageAuthors(ctx context.Context, cursor PageAuthorsCursor, limit int) (PageAuthorsPage, error)
+	RenameAuthors(ctx context.Context, arg []RenameAuthorsParams) *RenameAuthorsBatchResults
+	UpdateBio(ctx context.Context, arg UpdateBioParams) error
+	// RunInTxDAL runs fn with a DAL using a transaction. See RunInTx for
+	// how it's committed, rolled back, retried and nested.
+	RunInTxDAL(ctx context.Context, opts *sql.TxOptions, fn func(DAL) error) error
+}
+
+type AuthorNamesRow struct {
+	ID   int64
+	Name string
+}
+
+type CreateAuthorParams struct {
+	Name string
+	Bio  sql.NullString
+}
+
+type CreateAuthorsParams struct {
+	Name string
+	Bio  sql.NullString
+}
+
+type PageAuthorsRow struct {
+	ID   int64
+	Name string
+}
+
+type RenameAuthorsParams struct {
+	Name string
+	ID   int64
+}
+
+type UpdateBioParams struct {
+	Bio sql.NullString
+	ID  int64
+}
@@ -0,0 +1,541 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.18.0
+// source: query.sql
+
+package querytest
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+const authorNamesSqlite = `-- name: AuthorNames :map
+SELECT id, name FROM authors
+`
+
+var authorNamesSqliteInfo = QueryInfo{
+	Name:    "AuthorNames",
+	Command: ":map",
+	SQL:     authorNamesSqlite,
+	File:    "query.sql",
+}
+
+func (q *SqliteAccess) AuthorNames(ctx context.Context) (_ map[int64]AuthorNamesRow, err error) {
+	ctx = q.hookBefore(ctx, authorNamesSqliteInfo)
+	defer func() {
+		q.hookAfter(ctx, authorNamesSqliteInfo, err)
+	}()
+	return q.runAuthorNames(ctx)
+}
+
+func (q *SqliteAccess) runAuthorNames(ctx context.Context) (map[int64]AuthorNamesRow, error) {
+	rows, err := q.db.QueryContext(ctx, authorNamesSqlite)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := make(map[int64]AuthorNamesRow)
+	for rows.Next() {
+		var i AuthorNamesRow
+		if err := rows.Scan(&i.ID, &i.Name); err != nil {
+			return nil, err
+		}
+		items[i.ID] = i
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const createAuthorSqlite = `-- name: CreateAuthor :execlastid
+INSERT INTO authors (name, bio) VALUES (?, ?)
+`
+
+var createAuthorSqliteInfo = QueryInfo{
+	Name:    "CreateAuthor",
+	Command: ":execlastid",
+	SQL:     createAuthorSqlite,
+	File:    "query.sql",
+}
+
+func (q *SqliteAccess) CreateAuthor(ctx context.Context, arg CreateAuthorParams) (_ int64, err error) {
+	ctx = q.hookBefore(ctx, createAuthorSqliteInfo)
+	defer func() {
+		q.hookAfter(ctx, createAuthorSqliteInfo, err)
+	}()
+	return q.runCreateAuthor(ctx, arg)
+}
+
+func (q *SqliteAccess) runCreateAuthor(ctx context.Context, arg CreateAuthorParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, createAuthorSqlite, arg.Name, arg.Bio)
+	if err != nil {
+		return 0, err
+	}
+	return result.LastInsertId()
+}
+
+const createAuthorsSqlite = `-- name: CreateAuthors :copyfrom
+INSERT INTO authors (name, bio) VALUES (?, ?)
+`
+
+var createAuthorsSqliteInfo = QueryInfo{
+	Name:    "CreateAuthors",
+	Command: ":copyfrom",
+	SQL:     createAuthorsSqlite,
+	File:    "query.sql",
+}
+
+const deleteAuthorsSqlite = `-- name: DeleteAuthors :execrows
+DELETE FROM authors
+WHERE name = ?
+`
+
+var deleteAuthorsSqliteInfo = QueryInfo{
+	Name:    "DeleteAuthors",
+	Command: ":execrows",
+	SQL:     deleteAuthorsSqlite,
+	File:    "query.sql",
+}
+
+func (q *SqliteAccess) DeleteAuthors(ctx context.Context, name string) (_ int64, err error) {
+	ctx = q.hookBefore(ctx, deleteAuthorsSqliteInfo)
+	defer func() {
+		q.hookAfter(ctx, deleteAuthorsSqliteInfo, err)
+	}()
+	return q.runDeleteAuthors(ctx, name)
+}
+
+func (q *SqliteAccess) runDeleteAuthors(ctx context.Context, name string) (int64, error) {
+	result, err := q.db.ExecContext(ctx, deleteAuthorsSqlite, name)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const eachAuthorSqlite = `-- name: EachAuthor :iter
+SELECT id, name, bio, created_at FROM authors
+WHERE name LIKE ?
+`
+
+var eachAuthorSqliteInfo = QueryInfo{
+	Name:    "EachAuthor",
+	Command: ":iter",
+	SQL:     eachAuthorSqlite,
+	File:    "query.sql",
+}
+
+func (q *SqliteAccess) EachAuthor(ctx context.Context, name string, fn func(Author) error) (err error) {
+	ctx = q.hookBefore(ctx, eachAuthorSqliteInfo)
+	defer func() {
+		q.hookAfter(ctx, eachAuthorSqliteInfo, err)
+	}()
+	return q.runEachAuthor(ctx, name, fn)
+}
+
+func (q *SqliteAccess) runEachAuthor(ctx context.Context, name string, fn func(Author) error) error {
+	rows, err := q.db.QueryContext(ctx, eachAuthorSqlite, name)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var i Author
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.Bio,
+			&i.CreatedAt,
+		); err != nil {
+			return err
+		}
+		if err := fn(i); err != nil {
+			return err
+		}
+	}
+	if err := rows.Close(); err != nil {
+		return err
+	}
+	return rows.Err()
+}
+
+const findAuthorSqlite = `-- name: FindAuthor :opt
+SELECT id, name, bio, created_at FROM authors
+WHERE name = ?
+`
+
+var findAuthorSqliteInfo = QueryInfo{
+	Name:    "FindAuthor",
+	Command: ":opt",
+	SQL:     findAuthorSqlite,
+	File:    "query.sql",
+}
+
+func (q *SqliteAccess) FindAuthor(ctx context.Context, name string) (_ Author, _ bool, err error) {
+	ctx = q.hookBefore(ctx, findAuthorSqliteInfo)
+	defer func() {
+		q.hookAfter(ctx, findAuthorSqliteInfo, err)
+	}()
+	return q.runFindAuthor(ctx, name)
+}
+
+func (q *SqliteAccess) runFindAuthor(ctx context.Context, name string) (Author, bool, error) {
+	row := q.db.QueryRowContext(ctx, findAuthorSqlite, name)
+	var i Author
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.Bio,
+		&i.CreatedAt,
+	)
+	if errors.Is(err, sql.ErrNoRows) {
+		return i, false, nil
+	}
+	return i, err == nil, err
+}
+
+const getAuthorSqlite = `-- name: GetAuthor :one
+SELECT id, name, bio, created_at FROM authors
+WHERE id = ?
+`
+
+var getAuthorSqliteInfo = QueryInfo{
+	Name:    "GetAuthor",
+	Command: ":one",
+	SQL:     getAuthorSqlite,
+	File:    "query.sql",
+}
+
+func (q *SqliteAccess) GetAuthor(ctx context.Context, id int64) (_ Author, err error) {
+	ctx = q.hookBefore(ctx, getAuthorSqliteInfo)
+	defer func() {
+		q.hookAfter(ctx, getAuthorSqliteInfo, err)
+	}()
+	return q.runGetAuthor(ctx, id)
+}
+
+func (q *SqliteAccess) runGetAuthor(ctx context.Context, id int64) (Author, error) {
+	row := q.db.QueryRowContext(ctx, getAuthorSqlite, id)
+	var i Author
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.Bio,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getAuthorsByIDSqlite = `-- name: GetAuthorsByID :batchone
+SELECT id, name, bio, created_at FROM authors
+WHERE id = ?
+`
+
+var getAuthorsByIDSqliteInfo = QueryInfo{
+	Name:    "GetAuthorsByID",
+	Command: ":batchone",
+	SQL:     getAuthorsByIDSqlite,
+	File:    "query.sql",
+}
+
+const listAuthorsSqlite = `-- name: ListAuthors :many
+SELECT id, name, bio, created_at FROM authors
+ORDER BY /*SORT:authors.id,authors.name,authors.bio,authors.created_at*/authors.id ASC
+`
+
+var listAuthorsSqliteInfo = QueryInfo{
+	Name:    "ListAuthors",
+	Command: ":many",
+	SQL:     listAuthorsSqlite,
+	File:    "query.sql",
+}
+
+// ListAuthorsSort is a column SqliteAccess.ListAuthors can sort by.
+type ListAuthorsSort string
+
+const (
+	ListAuthorsSortID        ListAuthorsSort = "id"
+	ListAuthorsSortName      ListAuthorsSort = "name"
+	ListAuthorsSortBio       ListAuthorsSort = "bio"
+	ListAuthorsSortCreatedAt ListAuthorsSort = "created_at"
+)
+
+func (s ListAuthorsSort) Valid() bool {
+	switch s {
+	case ListAuthorsSortID,
+		ListAuthorsSortName,
+		ListAuthorsSortBio,
+		ListAuthorsSortCreatedAt:
+		return true
+	}
+	return false
+}
+
+// orderBy returns the column to sort by. Values that aren't valid fall back
+// to ListAuthorsSortID, so only known columns end up in the query.
+func (s ListAuthorsSort) orderBy() string {
+	switch s {
+	case ListAuthorsSortID:
+		return "authors.id"
+	case ListAuthorsSortName:
+		return "authors.name"
+	case ListAuthorsSortBio:
+		return "authors.bio"
+	case ListAuthorsSortCreatedAt:
+		return "authors.created_at"
+	}
+	return "authors.id"
+}
+
+func (q *SqliteAccess) ListAuthors(ctx context.Context, sortBy ListAuthorsSort, sortDir SortDirection) (_ []Author, err error) {
+	ctx = q.hookBefore(ctx, listAuthorsSqliteInfo)
+	defer func() {
+		q.hookAfter(ctx, listAuthorsSqliteInfo, err)
+	}()
+	return q.runListAuthors(ctx, sortBy, sortDir)
+}
+
+func (q *SqliteAccess) runListAuthors(ctx context.Context, sortBy ListAuthorsSort, sortDir SortDirection) ([]Author, error) {
+	query := listAuthorsSqlite
+	var queryParams []interface{}
+	query = strings.Replace(query, "/*SORT:authors.id,authors.name,authors.bio,authors.created_at*/authors.id ASC", sortBy.orderBy()+" "+sortDir.sql(), 1)
+	rows, err := q.db.QueryContext(ctx, query, queryParams...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Author
+	for rows.Next() {
+		var i Author
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.Bio,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listAuthorsByIDsSqlite = `-- name: ListAuthorsByIDs :many
+SELECT id, name, bio, created_at FROM authors
+WHERE id IN (/*SLICE:ids*/?)
+`
+
+var listAuthorsByIDsSqliteInfo = QueryInfo{
+	Name:    "ListAuthorsByIDs",
+	Command: ":many",
+	SQL:     listAuthorsByIDsSqlite,
+	File:    "query.sql",
+}
+
+func (q *SqliteAccess) ListAuthorsByIDs(ctx context.Context, ids []int64) (_ []Author, err error) {
+	ctx = q.hookBefore(ctx, listAuthorsByIDsSqliteInfo)
+	defer func() {
+		q.hookAfter(ctx, listAuthorsByIDsSqliteInfo, err)
+	}()
+	return q.runListAuthorsByIDs(ctx, ids)
+}
+
+func (q *SqliteAccess) runListAuthorsByIDs(ctx context.Context, ids []int64) ([]Author, error) {
+	if len(ids) > 2 {
+		var items []Author
+		for start := 0; start < len(ids); start += 2 {
+			end := start + 2
+			if end > len(ids) {
+				end = len(ids)
+			}
+			part, err := q.runListAuthorsByIDs(ctx, ids[start:end])
+			if err != nil {
+				return nil, err
+			}
+			items = append(items, part...)
+		}
+		return items, nil
+	}
+	query := listAuthorsByIDsSqlite
+	var queryParams []interface{}
+	if len(ids) > 0 {
+		for _, v := range ids {
+			queryParams = append(queryParams, v)
+		}
+		query = strings.Replace(query, "/*SLICE:ids*/?", strings.Repeat(",?", len(ids))[1:], 1)
+	} else {
+		query = strings.Replace(query, "/*SLICE:ids*/?", "NULL", 1)
+	}
+	rows, err := q.db.QueryContext(ctx, query, queryParams...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Author
+	for rows.Next() {
+		var i Author
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.Bio,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listAuthorsByNameSqlite = `-- name: ListAuthorsByName :batchmany
+SELECT id, name, bio, created_at FROM authors
+WHERE name = ?
+`
+
+var listAuthorsByNameSqliteInfo = QueryInfo{
+	Name:    "ListAuthorsByName",
+	Command: ":batchmany",
+	SQL:     listAuthorsByNameSqlite,
+	File:    "query.sql",
+}
+
+const pageAuthorsSqlite = `-- name: PageAuthors :paginate
+SELECT id, name FROM authors /*PAGINATE:WHERE:id ASC 0*/
+ORDER BY id
+`
+
+var pageAuthorsSqliteInfo = QueryInfo{
+	Name:    "PageAuthors",
+	Command: ":paginate",
+	SQL:     pageAuthorsSqlite,
+	File:    "query.sql",
+}
+
+// PageAuthorsPage is a page of PageAuthors results.
+type PageAuthorsPage struct {
+	Items []PageAuthorsRow
+	// Next is the cursor of the following page, empty on the last page
+	Next PageAuthorsCursor
+}
+
+// PageAuthorsCursor is an opaque position in the results of
+// PageAuthors. The empty cursor starts from the first row.
+type PageAuthorsCursor string
+
+type pageAuthorsKey struct {
+	ID int64
+}
+
+func (q *SqliteAccess) PageAuthors(ctx context.Context, cursor PageAuthorsCursor, limit int) (_ PageAuthorsPage, err error) {
+	ctx = q.hookBefore(ctx, pageAuthorsSqliteInfo)
+	defer func() {
+		q.hookAfter(ctx, pageAuthorsSqliteInfo, err)
+	}()
+	return q.runPageAuthors(ctx, cursor, limit)
+}
+
+func (q *SqliteAccess) runPageAuthors(ctx context.Context, cursor PageAuthorsCursor, limit int) (PageAuthorsPage, error) {
+	if limit < 1 {
+		return PageAuthorsPage{}, fmt.Errorf("limit must be at least 1; got %d", limit)
+	}
+	query := pageAuthorsSqlite
+	var queryParams []interface{}
+	if cursor != "" {
+		var key pageAuthorsKey
+		if err := decodeCursor(string(cursor), &key); err != nil {
+			return PageAuthorsPage{}, err
+		}
+		query = strings.Replace(query, "/*PAGINATE:WHERE:id ASC 0*/", "WHERE id > ?", 1)
+		queryParams = append(queryParams, key.ID)
+	}
+	query += " LIMIT ?"
+	queryParams = append(queryParams, limit+1)
+	rows, err := q.db.QueryContext(ctx, query, queryParams...)
+	if err != nil {
+		return PageAuthorsPage{}, err
+	}
+	defer rows.Close()
+	var page PageAuthorsPage
+	for rows.Next() {
+		var i PageAuthorsRow
+		if err := rows.Scan(&i.ID, &i.Name); err != nil {
+			return PageAuthorsPage{}, err
+		}
+		page.Items = append(page.Items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return PageAuthorsPage{}, err
+	}
+	if err := rows.Err(); err != nil {
+		return PageAuthorsPage{}, err
+	}
+	if len(page.Items) > limit {
+		page.Items = page.Items[:limit]
+		last := page.Items[limit-1]
+		next, err := encodeCursor(pageAuthorsKey{
+			ID: last.ID,
+		})
+		if err != nil {
+			return PageAuthorsPage{}, err
+		}
+		page.Next = PageAuthorsCursor(next)
+	}
+	return page, nil
+}
+
+const renameAuthorsSqlite = `-- name: RenameAuthors :batchexec
+UPDATE authors SET name = ?
+WHERE id = ?
+`
+
+var renameAuthorsSqliteInfo = QueryInfo{
+	Name:    "RenameAuthors",
+	Command: ":batchexec",
+	SQL:     renameAuthorsSqlite,
+	File:    "query.sql",
+}
+
+const updateBioSqlite = `-- name: UpdateBio :exec
+UPDATE authors SET bio = ?
+WHERE id = ?
+`
+
+var updateBioSqliteInfo = QueryInfo{
+	Name:    "UpdateBio",
+	Command: ":exec",
+	SQL:     updateBioSqlite,
+	File:    "query.sql",
+}
+
+func (q *SqliteAccess) UpdateBio(ctx context.Context, arg UpdateBioParams) (err error) {
+	ctx = q.hookBefore(ctx, updateBioSqliteInfo)
+	defer func() {
+		q.hookAfter(ctx, updateBioSqliteInfo, err)
+	}()
+	return q.runUpdateBio(ctx, arg)
+}
+
+func (q *SqliteAccess) runUpdateBio(ctx context.Context, arg UpdateBioParams) error {
+	_, err := q.db.ExecContext(ctx, updateBioSqlite, arg.Bio, arg.ID)
+	return err
+}
@@ -0,0 +1,52 @@
+-- name: GetAuthor :one
+SELECT id, name, bio, created_at FROM authors
+WHERE id = ?;
+
+-- name: FindAuthor :opt
+SELECT id, name, bio, created_at FROM authors
+WHERE name = ?;
+
+-- name: ListAuthors :many
+SELECT id, name, bio, created_at FROM authors
+ORDER BY sqlc.sort(authors);
+
+-- name: ListAuthorsByIDs :many chunk=2
+SELECT id, name, bio, created_at FROM authors
+WHERE id IN (sqlc.slice(ids));
+
+-- name: EachAuthor :iter
+SELECT id, name, bio, created_at FROM authors
+WHERE name LIKE ?;
+
+-- name: AuthorNames :map key=id
+SELECT id, name FROM authors;
+
+-- name: PageAuthors :paginate
+SELECT id, name FROM authors
+ORDER BY id;
+
+-- name: CreateAuthor :execlastid
+INSERT INTO authors (name, bio) VALUES (?, ?);
+
+-- name: CreateAuthors :copyfrom
+INSERT INTO authors (name, bio) VALUES (?, ?);
+
+-- name: UpdateBio :exec
+UPDATE authors SET bio = ?
+WHERE id = ?;
+
+-- name: DeleteAuthors :execrows
+DELETE FROM authors
+WHERE name = ?;
+
+-- name: RenameAuthors :batchexec
+UPDATE authors SET name = ?
+WHERE id = ?;
+
+-- name: GetAuthorsByID :batchone
+SELECT * FROM authors
+WHERE id = ?;
+
+-- name: ListAuthorsByName :batchmany
+SELECT * FROM authors
+WHERE name = ?;
@@ -0,0 +1,6 @@
+CREATE TABLE authors (
+  id         integer PRIMARY KEY,
+  name       text    NOT NULL,
+  bio        text,
+  created_at integer NOT NULL DEFAULT 0
+);
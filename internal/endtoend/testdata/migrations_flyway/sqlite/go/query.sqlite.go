@@ -0,0 +1,19 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.18.0
+// source: query.sql
+
+package querytest
+
+import "context"
+
+const getAuthorSqlite = `-- name: GetAuthor :one
+SELECT id, name, biography FROM authors WHERE id = ?
+`
+
+func (q *SqliteAccess) GetAuthor(ctx context.Context, id int64) (Author, error) {
+	row := q.db.QueryRowContext(ctx, getAuthorSqlite, id)
+	var i Author
+	err := row.Scan(&i.ID, &i.Name, &i.Biography)
+	return i, err
+}
@@ -0,0 +1,2 @@
+-- name: GetAuthor :one
+SELECT id, name, biography FROM authors WHERE id = ?;
@@ -0,0 +1,28 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.18.0
+
+package querytest
+
+import (
+	"database/sql"
+)
+
+// NewMysql returns a MysqlAccess running SELECTs that don't lock rows on
+// replica, and every other query on db. After WithTx, every query runs in the
+// transaction.
+func NewMysql(db DBTX, replica DBTX) *MysqlAccess {
+	return &MysqlAccess{db: db, replica: replica}
+}
+
+type MysqlAccess struct {
+	db      DBTX
+	replica DBTX
+}
+
+func (q *MysqlAccess) WithTx(tx *sql.Tx) *MysqlAccess {
+	return &MysqlAccess{
+		db:      tx,
+		replica: tx,
+	}
+}
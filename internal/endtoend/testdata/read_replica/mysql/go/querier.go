@@ -0,0 +1,35 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.18.0
+
+package querytest
+
+import (
+	"database/sql"
+	"io"
+
+	"context"
+)
+
+type DBTX interface {
+	ExecContext(context.Context, string, ...interface{}) (sql.Result, error)
+	PrepareContext(context.Context, string) (*sql.Stmt, error)
+	QueryContext(context.Context, string, ...interface{}) (*sql.Rows, error)
+	QueryRowContext(context.Context, string, ...interface{}) *sql.Row
+}
+
+type DAL interface {
+	io.Closer
+	CreateAuthor(ctx context.Context, arg CreateAuthorParams) (int64, error)
+	DeleteAuthor(ctx context.Context, id int64) error
+	GetAuthor(ctx context.Context, id int64) (Author, error)
+	GetAuthorForUpdate(ctx context.Context, id int64) (Author, error)
+	GetAuthorFromPrimary(ctx context.Context, id int64) (Author, error)
+	GetAuthorShared(ctx context.Context, id int64) (Author, error)
+	ListAuthors(ctx context.Context) ([]Author, error)
+}
+
+type CreateAuthorParams struct {
+	Name string
+	Bio  sql.NullString
+}
@@ -0,0 +1,108 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.18.0
+// source: query.sql
+
+package querytest
+
+import "context"
+
+const createAuthorMysql = `-- name: CreateAuthor :execlastid
+INSERT INTO authors (name, bio) VALUES (?, ?)
+`
+
+func (q *MysqlAccess) CreateAuthor(ctx context.Context, arg CreateAuthorParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, createAuthorMysql, arg.Name, arg.Bio)
+	if err != nil {
+		return 0, err
+	}
+	return result.LastInsertId()
+}
+
+const deleteAuthorMysql = `-- name: DeleteAuthor :exec
+DELETE FROM authors
+WHERE id = ?
+`
+
+func (q *MysqlAccess) DeleteAuthor(ctx context.Context, id int64) error {
+	_, err := q.db.ExecContext(ctx, deleteAuthorMysql, id)
+	return err
+}
+
+const getAuthorMysql = `-- name: GetAuthor :one
+SELECT id, name, bio FROM authors
+WHERE id = ? LIMIT 1
+`
+
+func (q *MysqlAccess) GetAuthor(ctx context.Context, id int64) (Author, error) {
+	row := q.replica.QueryRowContext(ctx, getAuthorMysql, id)
+	var i Author
+	err := row.Scan(&i.ID, &i.Name, &i.Bio)
+	return i, err
+}
+
+const getAuthorForUpdateMysql = `-- name: GetAuthorForUpdate :one
+SELECT id, name, bio FROM authors
+WHERE id = ? LIMIT 1
+FOR UPDATE
+`
+
+func (q *MysqlAccess) GetAuthorForUpdate(ctx context.Context, id int64) (Author, error) {
+	row := q.db.QueryRowContext(ctx, getAuthorForUpdateMysql, id)
+	var i Author
+	err := row.Scan(&i.ID, &i.Name, &i.Bio)
+	return i, err
+}
+
+const getAuthorFromPrimaryMysql = `-- name: GetAuthorFromPrimary :one
+SELECT id, name, bio FROM authors
+WHERE id = ? LIMIT 1
+`
+
+func (q *MysqlAccess) GetAuthorFromPrimary(ctx context.Context, id int64) (Author, error) {
+	row := q.db.QueryRowContext(ctx, getAuthorFromPrimaryMysql, id)
+	var i Author
+	err := row.Scan(&i.ID, &i.Name, &i.Bio)
+	return i, err
+}
+
+const getAuthorSharedMysql = `-- name: GetAuthorShared :one
+SELECT id, name, bio FROM authors
+WHERE id = ? LIMIT 1
+LOCK IN SHARE MODE
+`
+
+func (q *MysqlAccess) GetAuthorShared(ctx context.Context, id int64) (Author, error) {
+	row := q.db.QueryRowContext(ctx, getAuthorSharedMysql, id)
+	var i Author
+	err := row.Scan(&i.ID, &i.Name, &i.Bio)
+	return i, err
+}
+
+const listAuthorsMysql = `-- name: ListAuthors :many
+SELECT id, name, bio FROM authors
+ORDER BY name
+`
+
+func (q *MysqlAccess) ListAuthors(ctx context.Context) ([]Author, error) {
+	rows, err := q.replica.QueryContext(ctx, listAuthorsMysql)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Author
+	for rows.Next() {
+		var i Author
+		if err := rows.Scan(&i.ID, &i.Name, &i.Bio); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
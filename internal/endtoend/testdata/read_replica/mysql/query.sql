@@ -0,0 +1,28 @@
+-- name: GetAuthor :one
+SELECT id, name, bio FROM authors
+WHERE id = ? LIMIT 1;
+
+-- name: GetAuthorForUpdate :one
+SELECT id, name, bio FROM authors
+WHERE id = ? LIMIT 1
+FOR UPDATE;
+
+-- name: GetAuthorShared :one
+SELECT id, name, bio FROM authors
+WHERE id = ? LIMIT 1
+LOCK IN SHARE MODE;
+
+-- name: GetAuthorFromPrimary :one primary=true
+SELECT id, name, bio FROM authors
+WHERE id = ? LIMIT 1;
+
+-- name: ListAuthors :many
+SELECT id, name, bio FROM authors
+ORDER BY name;
+
+-- name: CreateAuthor :execlastid
+INSERT INTO authors (name, bio) VALUES (?, ?);
+
+-- name: DeleteAuthor :exec
+DELETE FROM authors
+WHERE id = ?;
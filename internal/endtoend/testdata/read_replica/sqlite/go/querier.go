@@ -0,0 +1,36 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.18.0
+
+package querytest
+
+import (
+	"database/sql"
+	"io"
+
+	"context"
+)
+
+type DBTX interface {
+	ExecContext(context.Context, string, ...interface{}) (sql.Result, error)
+	PrepareContext(context.Context, string) (*sql.Stmt, error)
+	QueryContext(context.Context, string, ...interface{}) (*sql.Rows, error)
+	QueryRowContext(context.Context, string, ...interface{}) *sql.Row
+}
+
+type DAL interface {
+	io.Closer
+	CreateAuthor(ctx context.Context, arg CreateAuthorParams) (Author, error)
+	DeleteAuthor(ctx context.Context, id int64) (string, bool, error)
+	GetAuthor(ctx context.Context, id int64) (Author, bool, error)
+	GetAuthorFromPrimary(ctx context.Context, id int64) (Author, error)
+	ListAuthors(ctx context.Context, fn func(Author) error) error
+	// RunInTxDAL runs fn with a DAL using a transaction. See RunInTx for
+	// how it's committed, rolled back, retried and nested.
+	RunInTxDAL(ctx context.Context, opts *sql.TxOptions, fn func(DAL) error) error
+}
+
+type CreateAuthorParams struct {
+	Name string
+	Bio  sql.NullString
+}
@@ -0,0 +1,93 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.18.0
+// source: query.sql
+
+package querytest
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+)
+
+const createAuthorSqlite = `-- name: CreateAuthor :one
+INSERT INTO authors (name, bio) VALUES (?, ?)
+RETURNING id, name, bio
+`
+
+func (q *SqliteAccess) CreateAuthor(ctx context.Context, arg CreateAuthorParams) (Author, error) {
+	row := q.db.QueryRowContext(ctx, createAuthorSqlite, arg.Name, arg.Bio)
+	var i Author
+	err := row.Scan(&i.ID, &i.Name, &i.Bio)
+	return i, err
+}
+
+const deleteAuthorSqlite = `-- name: DeleteAuthor :opt
+DELETE FROM authors
+WHERE id = ?
+RETURNING name
+`
+
+func (q *SqliteAccess) DeleteAuthor(ctx context.Context, id int64) (string, bool, error) {
+	row := q.db.QueryRowContext(ctx, deleteAuthorSqlite, id)
+	var name string
+	err := row.Scan(&name)
+	if errors.Is(err, sql.ErrNoRows) {
+		return name, false, nil
+	}
+	return name, err == nil, err
+}
+
+const getAuthorSqlite = `-- name: GetAuthor :opt
+SELECT id, name, bio FROM authors
+WHERE id = ?
+`
+
+func (q *SqliteAccess) GetAuthor(ctx context.Context, id int64) (Author, bool, error) {
+	row := q.replica.QueryRowContext(ctx, getAuthorSqlite, id)
+	var i Author
+	err := row.Scan(&i.ID, &i.Name, &i.Bio)
+	if errors.Is(err, sql.ErrNoRows) {
+		return i, false, nil
+	}
+	return i, err == nil, err
+}
+
+const getAuthorFromPrimarySqlite = `-- name: GetAuthorFromPrimary :one
+SELECT id, name, bio FROM authors
+WHERE id = ?
+`
+
+func (q *SqliteAccess) GetAuthorFromPrimary(ctx context.Context, id int64) (Author, error) {
+	row := q.db.QueryRowContext(ctx, getAuthorFromPrimarySqlite, id)
+	var i Author
+	err := row.Scan(&i.ID, &i.Name, &i.Bio)
+	return i, err
+}
+
+const listAuthorsSqlite = `-- name: ListAuthors :iter
+SELECT id, name, bio FROM authors
+ORDER BY name
+`
+
+func (q *SqliteAccess) ListAuthors(ctx context.Context, fn func(Author) error) error {
+	rows, err := q.replica.QueryContext(ctx, listAuthorsSqlite)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var i Author
+		if err := rows.Scan(&i.ID, &i.Name, &i.Bio); err != nil {
+			return err
+		}
+		if err := fn(i); err != nil {
+			return err
+		}
+	}
+	if err := rows.Close(); err != nil {
+		return err
+	}
+	return rows.Err()
+}
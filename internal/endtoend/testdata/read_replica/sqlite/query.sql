@@ -0,0 +1,20 @@
+-- name: GetAuthor :opt
+SELECT id, name, bio FROM authors
+WHERE id = ?;
+
+-- name: GetAuthorFromPrimary :one primary=true
+SELECT id, name, bio FROM authors
+WHERE id = ?;
+
+-- name: ListAuthors :iter
+SELECT id, name, bio FROM authors
+ORDER BY name;
+
+-- name: CreateAuthor :one
+INSERT INTO authors (name, bio) VALUES (?, ?)
+RETURNING id, name, bio;
+
+-- name: DeleteAuthor :opt
+DELETE FROM authors
+WHERE id = ?
+RETURNING name;
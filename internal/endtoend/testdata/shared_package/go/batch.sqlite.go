@@ -0,0 +1,22 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.18.0
+// source: batch.go
+
+package querytest
+
+import (
+	"context"
+)
+
+func (q *SqliteAccess) UpdateBio(ctx context.Context, arg []UpdateBioParams) *UpdateBioBatchResults {
+	args := make([][]interface{}, 0, len(arg))
+	for _, a := range arg {
+		args = append(args, []interface{}{
+			a.Bio,
+			a.ID,
+		})
+	}
+	br := newBatch(ctx, q.db, false, updateBioSqlite, args)
+	return &UpdateBioBatchResults{br, len(arg), false}
+}
@@ -0,0 +1,56 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.18.0
+// source: copyfrom.go
+
+package querytest
+
+import (
+	"context"
+	"strings"
+)
+
+// copyFromMaxParamsMysql is the largest number of placeholders in a statement.
+const copyFromMaxParamsMysql = 65535
+
+// insertInsertAuthorsMysql inserts rows with as few statements as the
+// placeholder limit allows.
+func insertInsertAuthorsMysql(ctx context.Context, db DBTX, arg []InsertAuthorsParams) (int64, error) {
+	const chunkSize = copyFromMaxParamsMysql / 2
+	var total int64
+	for len(arg) > 0 {
+		chunk := arg
+		if len(chunk) > chunkSize {
+			chunk = chunk[:chunkSize]
+		}
+		arg = arg[len(chunk):]
+		queryParams := make([]interface{}, 0, len(chunk)*2)
+		for _, row := range chunk {
+			queryParams = append(queryParams, row.Name, row.Bio)
+		}
+		query := "INSERT INTO authors (name, bio) VALUES " + strings.Repeat("(?, ?), ", len(chunk)-1) + "(?, ?)"
+		result, err := db.ExecContext(ctx, query, queryParams...)
+		if err != nil {
+			return total, err
+		}
+		n, err := result.RowsAffected()
+		if err != nil {
+			return total, err
+		}
+		total += n
+	}
+	return total, nil
+}
+
+func (q *MysqlAccess) InsertAuthors(ctx context.Context, arg []InsertAuthorsParams) (int64, error) {
+	var total int64
+	err := copyFromTx(ctx, q.db, func(tx DBTX) error {
+		var err error
+		total, err = insertInsertAuthorsMysql(ctx, tx, arg)
+		return err
+	})
+	if err != nil {
+		return 0, err
+	}
+	return total, nil
+}
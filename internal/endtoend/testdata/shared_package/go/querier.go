@@ -0,0 +1,89 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.18.0
+
+package querytest
+
+import (
+	"database/sql"
+	"io"
+
+	"context"
+)
+
+type DBTX interface {
+	ExecContext(context.Context, string, ...interface{}) (sql.Result, error)
+	PrepareContext(context.Context, string) (*sql.Stmt, error)
+	QueryContext(context.Context, string, ...interface{}) (*sql.Rows, error)
+	QueryRowContext(context.Context, string, ...interface{}) *sql.Row
+}
+
+type DAL interface {
+	io.Closer
+	GetAuthor(ctx context.Context, id int64) (GetAuthorRow, error)
+	InsertAuthors(ctx context.Context, arg []InsertAuthorsParams) (int64, error)
+	ListAuthors(ctx context.Context, sortBy ListAuthorsSort, sortDir SortDirection) ([]ListAuthorsRow, error)
+	ListAuthorsPage(ctx context.Context, cursor ListAuthorsPageCursor, limit int) (ListAuthorsPagePage, error)
+	UpdateBio(ctx context.Context, arg []UpdateBioParams) *UpdateBioBatchResults
+}
+
+type GetAuthorRow struct {
+	ID   int64
+	Name string
+	Bio  sql.NullString
+}
+
+type InsertAuthorsParams struct {
+	Name string
+	Bio  sql.NullString
+}
+
+type ListAuthorsRow struct {
+	ID   int64
+	Name string
+	Bio  sql.NullString
+}
+
+// ListAuthorsSort is a column DAL.ListAuthors can sort by.
+type ListAuthorsSort string
+
+const (
+	ListAuthorsSortName ListAuthorsSort = "name"
+	ListAuthorsSortID   ListAuthorsSort = "id"
+)
+
+func (s ListAuthorsSort) Valid() bool {
+	switch s {
+	case ListAuthorsSortName,
+		ListAuthorsSortID:
+		return true
+	}
+	return false
+}
+
+type ListAuthorsPageRow struct {
+	ID   int64
+	Name string
+	Bio  sql.NullString
+}
+
+// ListAuthorsPagePage is a page of ListAuthorsPage results.
+type ListAuthorsPagePage struct {
+	Items []ListAuthorsPageRow
+	// Next is the cursor of the following page, empty on the last page
+	Next ListAuthorsPageCursor
+}
+
+// ListAuthorsPageCursor is an opaque position in the results of
+// ListAuthorsPage. The empty cursor starts from the first row.
+type ListAuthorsPageCursor string
+
+type listAuthorsPageKey struct {
+	Name string
+	ID   int64
+}
+
+type UpdateBioParams struct {
+	Bio sql.NullString
+	ID  int64
+}
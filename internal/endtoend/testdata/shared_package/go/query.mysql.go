@@ -0,0 +1,130 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.18.0
+// source: query.sql
+
+package querytest
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+const getAuthorMysql = `-- name: GetAuthor :one
+SELECT id, name, bio FROM authors
+WHERE id = ? LIMIT 1
+`
+
+func (q *MysqlAccess) GetAuthor(ctx context.Context, id int64) (GetAuthorRow, error) {
+	row := q.db.QueryRowContext(ctx, getAuthorMysql, id)
+	var i GetAuthorRow
+	err := row.Scan(&i.ID, &i.Name, &i.Bio)
+	return i, err
+}
+
+const insertAuthorsMysql = `-- name: InsertAuthors :copyfrom
+INSERT INTO authors (name, bio) VALUES (?, ?)
+`
+
+const listAuthorsMysql = `-- name: ListAuthors :many
+SELECT id, name, bio FROM authors
+ORDER BY /*SORT:name,id*/name ASC
+`
+
+// orderByMysql returns the column to sort by. Values that aren't valid fall back
+// to ListAuthorsSortName, so only known columns end up in the query.
+func (s ListAuthorsSort) orderByMysql() string {
+	switch s {
+	case ListAuthorsSortName:
+		return "name"
+	case ListAuthorsSortID:
+		return "id"
+	}
+	return "name"
+}
+
+func (q *MysqlAccess) ListAuthors(ctx context.Context, sortBy ListAuthorsSort, sortDir SortDirection) ([]ListAuthorsRow, error) {
+	query := listAuthorsMysql
+	var queryParams []interface{}
+	query = strings.Replace(query, "/*SORT:name,id*/name ASC", sortBy.orderByMysql()+" "+sortDir.sql(), 1)
+	rows, err := q.db.QueryContext(ctx, query, queryParams...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListAuthorsRow
+	for rows.Next() {
+		var i ListAuthorsRow
+		if err := rows.Scan(&i.ID, &i.Name, &i.Bio); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listAuthorsPageMysql = `-- name: ListAuthorsPage :paginate
+SELECT id, name, bio FROM authors /*PAGINATE:WHERE:name ASC 1,id ASC 0*/
+ORDER BY name, id
+`
+
+func (q *MysqlAccess) ListAuthorsPage(ctx context.Context, cursor ListAuthorsPageCursor, limit int) (ListAuthorsPagePage, error) {
+	if limit < 1 {
+		return ListAuthorsPagePage{}, fmt.Errorf("limit must be at least 1; got %d", limit)
+	}
+	query := listAuthorsPageMysql
+	var queryParams []interface{}
+	if cursor != "" {
+		var key listAuthorsPageKey
+		if err := decodeCursor(string(cursor), &key); err != nil {
+			return ListAuthorsPagePage{}, err
+		}
+		query = strings.Replace(query, "/*PAGINATE:WHERE:name ASC 1,id ASC 0*/", "WHERE (name, id) > (?, ?)", 1)
+		queryParams = append(queryParams, key.Name, key.ID)
+	}
+	query += " LIMIT ?"
+	queryParams = append(queryParams, limit+1)
+	rows, err := q.db.QueryContext(ctx, query, queryParams...)
+	if err != nil {
+		return ListAuthorsPagePage{}, err
+	}
+	defer rows.Close()
+	var page ListAuthorsPagePage
+	for rows.Next() {
+		var i ListAuthorsPageRow
+		if err := rows.Scan(&i.ID, &i.Name, &i.Bio); err != nil {
+			return ListAuthorsPagePage{}, err
+		}
+		page.Items = append(page.Items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return ListAuthorsPagePage{}, err
+	}
+	if err := rows.Err(); err != nil {
+		return ListAuthorsPagePage{}, err
+	}
+	if len(page.Items) > limit {
+		page.Items = page.Items[:limit]
+		last := page.Items[limit-1]
+		next, err := encodeCursor(listAuthorsPageKey{
+			Name: last.Name,
+			ID:   last.ID,
+		})
+		if err != nil {
+			return ListAuthorsPagePage{}, err
+		}
+		page.Next = ListAuthorsPageCursor(next)
+	}
+	return page, nil
+}
+
+const updateBioMysql = `-- name: UpdateBio :batchexec
+UPDATE authors SET bio = ? WHERE id = ?
+`
@@ -0,0 +1,11 @@
+CREATE TABLE authors (
+    id BIGINT NOT NULL AUTO_INCREMENT PRIMARY KEY,
+    name VARCHAR(255) NOT NULL,
+    bio TEXT,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TABLE audit_log (
+    id BIGINT NOT NULL AUTO_INCREMENT PRIMARY KEY,
+    message TEXT NOT NULL
+);
@@ -0,0 +1,17 @@
+-- name: GetAuthor :one
+SELECT id, name, bio FROM authors
+WHERE id = ? LIMIT 1;
+
+-- name: ListAuthors :many
+SELECT id, name, bio FROM authors
+ORDER BY sqlc.sort('name', 'id');
+
+-- name: ListAuthorsPage :paginate
+SELECT id, name, bio FROM authors
+ORDER BY name, id;
+
+-- name: InsertAuthors :copyfrom tx=true
+INSERT INTO authors (name, bio) VALUES (?, ?);
+
+-- name: UpdateBio :batchexec
+UPDATE authors SET bio = ? WHERE id = ?;
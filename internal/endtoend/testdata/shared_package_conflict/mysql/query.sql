@@ -0,0 +1,3 @@
+-- name: GetAuthorName :one
+SELECT name FROM authors
+WHERE id = ?;
@@ -0,0 +1,6 @@
+CREATE TABLE authors (
+    id BIGINT NOT NULL AUTO_INCREMENT PRIMARY KEY,
+    name VARCHAR(255) NOT NULL,
+    bio TEXT NOT NULL,
+    age INT NOT NULL
+);
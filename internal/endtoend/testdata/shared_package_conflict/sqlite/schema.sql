@@ -0,0 +1,6 @@
+CREATE TABLE authors (
+    id INTEGER NOT NULL PRIMARY KEY AUTOINCREMENT,
+    name TEXT NOT NULL,
+    bio TEXT,
+    age INTEGER NOT NULL
+);
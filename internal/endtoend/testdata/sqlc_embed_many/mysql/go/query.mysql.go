@@ -0,0 +1,212 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.18.0
+// source: query.sql
+
+package querytest
+
+import (
+	"context"
+	"database/sql"
+)
+
+const listMembershipsWithPostsMysql = `-- name: ListMembershipsWithPosts :many
+SELECT memberships.org_id, memberships.user_id, memberships.role, posts.id, posts.user_id, posts.title, posts.body
+FROM memberships
+LEFT JOIN posts ON posts.user_id = memberships.user_id
+`
+
+func (q *MysqlAccess) ListMembershipsWithPosts(ctx context.Context) ([]ListMembershipsWithPostsRow, error) {
+	rows, err := q.db.QueryContext(ctx, listMembershipsWithPostsMysql)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListMembershipsWithPostsRow
+	parents := make(map[[2]interface{}]int)
+	for rows.Next() {
+		var i ListMembershipsWithPostsRow
+		var nullPosts struct {
+			ID     *int32
+			UserID *int32
+			Title  *string
+			Body   *sql.NullString
+		}
+		if err := rows.Scan(
+			&i.Membership.OrgID,
+			&i.Membership.UserID,
+			&i.Membership.Role,
+			&nullPosts.ID,
+			&nullPosts.UserID,
+			&nullPosts.Title,
+			&nullPosts.Body,
+		); err != nil {
+			return nil, err
+		}
+		n, ok := parents[[2]interface{}{i.Membership.OrgID, i.Membership.UserID}]
+		if !ok {
+			n = len(items)
+			parents[[2]interface{}{i.Membership.OrgID, i.Membership.UserID}] = n
+			i.Posts = []Post{}
+			items = append(items, i)
+		}
+		if nullPosts.ID == nil && nullPosts.UserID == nil && nullPosts.Title == nil && nullPosts.Body == nil {
+			continue
+		}
+		var child Post
+		if nullPosts.ID != nil {
+			child.ID = *nullPosts.ID
+		}
+		if nullPosts.UserID != nil {
+			child.UserID = *nullPosts.UserID
+		}
+		if nullPosts.Title != nil {
+			child.Title = *nullPosts.Title
+		}
+		if nullPosts.Body != nil {
+			child.Body = *nullPosts.Body
+		}
+		items[n].Posts = append(items[n].Posts, child)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listUserNamesWithPostsMysql = `-- name: ListUserNamesWithPosts :many
+SELECT users.id, users.name, posts.id, posts.user_id, posts.title, posts.body
+FROM users
+LEFT JOIN posts ON posts.user_id = users.id
+WHERE users.name = ?
+`
+
+func (q *MysqlAccess) ListUserNamesWithPosts(ctx context.Context, name string) ([]ListUserNamesWithPostsRow, error) {
+	rows, err := q.db.QueryContext(ctx, listUserNamesWithPostsMysql, name)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListUserNamesWithPostsRow
+	parents := make(map[int32]int)
+	for rows.Next() {
+		var i ListUserNamesWithPostsRow
+		var nullPosts struct {
+			ID     *int32
+			UserID *int32
+			Title  *string
+			Body   *sql.NullString
+		}
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&nullPosts.ID,
+			&nullPosts.UserID,
+			&nullPosts.Title,
+			&nullPosts.Body,
+		); err != nil {
+			return nil, err
+		}
+		n, ok := parents[i.ID]
+		if !ok {
+			n = len(items)
+			parents[i.ID] = n
+			i.Posts = []Post{}
+			items = append(items, i)
+		}
+		if nullPosts.ID == nil && nullPosts.UserID == nil && nullPosts.Title == nil && nullPosts.Body == nil {
+			continue
+		}
+		var child Post
+		if nullPosts.ID != nil {
+			child.ID = *nullPosts.ID
+		}
+		if nullPosts.UserID != nil {
+			child.UserID = *nullPosts.UserID
+		}
+		if nullPosts.Title != nil {
+			child.Title = *nullPosts.Title
+		}
+		if nullPosts.Body != nil {
+			child.Body = *nullPosts.Body
+		}
+		items[n].Posts = append(items[n].Posts, child)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listUsersWithPostsMysql = `-- name: ListUsersWithPosts :many
+SELECT users.id, users.name, posts.id, posts.user_id, posts.title, posts.body
+FROM users
+LEFT JOIN posts ON posts.user_id = users.id
+ORDER BY users.id, posts.id
+`
+
+func (q *MysqlAccess) ListUsersWithPosts(ctx context.Context) ([]ListUsersWithPostsRow, error) {
+	rows, err := q.db.QueryContext(ctx, listUsersWithPostsMysql)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListUsersWithPostsRow
+	parents := make(map[int32]int)
+	for rows.Next() {
+		var i ListUsersWithPostsRow
+		var nullPosts struct {
+			ID     *int32
+			UserID *int32
+			Title  *string
+			Body   *sql.NullString
+		}
+		if err := rows.Scan(
+			&i.User.ID,
+			&i.User.Name,
+			&nullPosts.ID,
+			&nullPosts.UserID,
+			&nullPosts.Title,
+			&nullPosts.Body,
+		); err != nil {
+			return nil, err
+		}
+		n, ok := parents[i.User.ID]
+		if !ok {
+			n = len(items)
+			parents[i.User.ID] = n
+			i.Posts = []Post{}
+			items = append(items, i)
+		}
+		if nullPosts.ID == nil && nullPosts.UserID == nil && nullPosts.Title == nil && nullPosts.Body == nil {
+			continue
+		}
+		var child Post
+		if nullPosts.ID != nil {
+			child.ID = *nullPosts.ID
+		}
+		if nullPosts.UserID != nil {
+			child.UserID = *nullPosts.UserID
+		}
+		if nullPosts.Title != nil {
+			child.Title = *nullPosts.Title
+		}
+		if nullPosts.Body != nil {
+			child.Body = *nullPosts.Body
+		}
+		items[n].Posts = append(items[n].Posts, child)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
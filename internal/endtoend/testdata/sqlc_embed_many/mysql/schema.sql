@@ -0,0 +1,18 @@
+CREATE TABLE users (
+    id integer NOT NULL PRIMARY KEY,
+    name varchar(255) NOT NULL
+);
+
+CREATE TABLE posts (
+    id integer NOT NULL PRIMARY KEY,
+    user_id integer NOT NULL,
+    title varchar(255) NOT NULL,
+    body text
+);
+
+CREATE TABLE memberships (
+    org_id integer NOT NULL,
+    user_id integer NOT NULL,
+    role varchar(255) NOT NULL,
+    PRIMARY KEY (org_id, user_id)
+);
@@ -0,0 +1,23 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.18.0
+
+package querytest
+
+import (
+	"database/sql"
+)
+
+func NewSqlite(db DBTX) *SqliteAccess {
+	return &SqliteAccess{db: db}
+}
+
+type SqliteAccess struct {
+	db DBTX
+}
+
+func (q *SqliteAccess) WithTx(tx *sql.Tx) *SqliteAccess {
+	return &SqliteAccess{
+		db: tx,
+	}
+}
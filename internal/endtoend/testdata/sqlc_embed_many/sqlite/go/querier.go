@@ -0,0 +1,42 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.18.0
+
+package querytest
+
+import (
+	"database/sql"
+	"io"
+
+	"context"
+)
+
+type DBTX interface {
+	ExecContext(context.Context, string, ...interface{}) (sql.Result, error)
+	PrepareContext(context.Context, string) (*sql.Stmt, error)
+	QueryContext(context.Context, string, ...interface{}) (*sql.Rows, error)
+	QueryRowContext(context.Context, string, ...interface{}) *sql.Row
+}
+
+type DAL interface {
+	io.Closer
+	ListMembershipsWithPosts(ctx context.Context) ([]ListMembershipsWithPostsRow, error)
+	ListUserNamesWithPosts(ctx context.Context, name string) ([]ListUserNamesWithPostsRow, error)
+	ListUsersWithPosts(ctx context.Context) ([]ListUsersWithPostsRow, error)
+}
+
+type ListMembershipsWithPostsRow struct {
+	Membership Membership
+	Posts      []Post
+}
+
+type ListUserNamesWithPostsRow struct {
+	ID    int64
+	Name  string
+	Posts []Post
+}
+
+type ListUsersWithPostsRow struct {
+	User  User
+	Posts []Post
+}
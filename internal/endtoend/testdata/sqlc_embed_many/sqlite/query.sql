@@ -0,0 +1,16 @@
+-- name: ListUsersWithPosts :many
+SELECT sqlc.embed(users), sqlc.embed_many(posts)
+FROM users
+LEFT JOIN posts ON posts.user_id = users.id
+ORDER BY users.id, posts.id;
+
+-- name: ListUserNamesWithPosts :many
+SELECT users.id, users.name, sqlc.embed_many(posts)
+FROM users
+LEFT JOIN posts ON posts.user_id = users.id
+WHERE users.name = ?;
+
+-- name: ListMembershipsWithPosts :many
+SELECT sqlc.embed(memberships), sqlc.embed_many(posts)
+FROM memberships
+LEFT JOIN posts ON posts.user_id = memberships.user_id;
@@ -0,0 +1,18 @@
+CREATE TABLE users (
+    id integer NOT NULL PRIMARY KEY,
+    name text NOT NULL
+);
+
+CREATE TABLE posts (
+    id integer NOT NULL PRIMARY KEY,
+    user_id integer NOT NULL,
+    title text NOT NULL,
+    body text
+);
+
+CREATE TABLE memberships (
+    org_id integer NOT NULL,
+    user_id integer NOT NULL,
+    role text NOT NULL,
+    PRIMARY KEY (org_id, user_id)
+);
@@ -0,0 +1,13 @@
+-- name: GetUserWithPosts :one
+SELECT sqlc.embed(users), sqlc.embed_many(posts)
+FROM users
+LEFT JOIN posts ON posts.user_id = users.id;
+
+-- name: ListUsersWithPostsTwice :many
+SELECT users.id, sqlc.embed_many(posts), sqlc.embed_many(p)
+FROM users
+LEFT JOIN posts ON posts.user_id = users.id
+LEFT JOIN posts p ON p.user_id = users.id;
+
+-- name: ListPosts :many
+SELECT sqlc.embed_many(posts) FROM posts;
@@ -0,0 +1,14 @@
+CREATE TABLE users (
+    id integer NOT NULL PRIMARY KEY,
+    name varchar(255) NOT NULL
+);
+
+CREATE TABLE posts (
+    id integer NOT NULL PRIMARY KEY,
+    user_id integer NOT NULL
+);
+
+CREATE TABLE tags (
+    post_id integer NOT NULL,
+    name varchar(255) NOT NULL
+);
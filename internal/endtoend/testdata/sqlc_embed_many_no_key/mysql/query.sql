@@ -0,0 +1,4 @@
+-- name: ListTagsWithPosts :many
+SELECT sqlc.embed(tags), sqlc.embed_many(posts)
+FROM tags
+LEFT JOIN posts ON posts.id = tags.post_id;
@@ -0,0 +1,7 @@
+CREATE TABLE users (
+    id BIGINT NOT NULL AUTO_INCREMENT PRIMARY KEY,
+    org_id BIGINT NOT NULL,
+    name VARCHAR(255) NOT NULL,
+    bio TEXT,
+    age INT NOT NULL
+);
@@ -0,0 +1,38 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.18.0
+
+package querytest
+
+import (
+	"database/sql"
+	"io"
+
+	"context"
+)
+
+type DBTX interface {
+	ExecContext(context.Context, string, ...interface{}) (sql.Result, error)
+	PrepareContext(context.Context, string) (*sql.Stmt, error)
+	QueryContext(context.Context, string, ...interface{}) (*sql.Rows, error)
+	QueryRowContext(context.Context, string, ...interface{}) *sql.Row
+}
+
+type DAL interface {
+	io.Closer
+	CountUsers(ctx context.Context, name *string) (int64, error)
+	DeactivateUsers(ctx context.Context, arg DeactivateUsersParams) (int64, error)
+	ListUsers(ctx context.Context, arg ListUsersParams) ([]User, error)
+}
+
+type DeactivateUsersParams struct {
+	OrgID  int64
+	MaxAge *int64
+}
+
+type ListUsersParams struct {
+	OrgID  int64
+	Name   *string
+	Bio    *string
+	MinAge *int64
+}
@@ -0,0 +1,102 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.18.0
+// source: query.sql
+
+package querytest
+
+import (
+	"context"
+	"strings"
+)
+
+const countUsersSqlite = `-- name: CountUsers :one
+SELECT count(*) FROM users
+WHERE (/*OPTIONAL:name*/name LIKE ?)
+`
+
+func (q *SqliteAccess) CountUsers(ctx context.Context, name *string) (int64, error) {
+	query := countUsersSqlite
+	var queryParams []interface{}
+	if name == nil {
+		query = strings.Replace(query, "(/*OPTIONAL:name*/name LIKE ?)", "(? IS NULL)", 1)
+	}
+	queryParams = append(queryParams, name)
+	row := q.db.QueryRowContext(ctx, query, queryParams...)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const deactivateUsersSqlite = `-- name: DeactivateUsers :execrows
+DELETE FROM users
+WHERE org_id = ? AND (/*OPTIONAL:max_age*/age < ?2)
+`
+
+func (q *SqliteAccess) DeactivateUsers(ctx context.Context, arg DeactivateUsersParams) (int64, error) {
+	query := deactivateUsersSqlite
+	var queryParams []interface{}
+	queryParams = append(queryParams, arg.OrgID)
+	if arg.MaxAge == nil {
+		query = strings.Replace(query, "(/*OPTIONAL:max_age*/age < ?2)", "(?2 IS NULL)", 1)
+	}
+	queryParams = append(queryParams, arg.MaxAge)
+	result, err := q.db.ExecContext(ctx, query, queryParams...)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const listUsersSqlite = `-- name: ListUsers :many
+SELECT id, org_id, name, bio, age FROM users
+WHERE org_id = ?1
+  AND (/*OPTIONAL:name*/name = ?2)
+  AND (/*OPTIONAL:bio*/bio = ?3)
+  AND (/*OPTIONAL:min_age*/age >= ?4)
+ORDER BY id
+`
+
+func (q *SqliteAccess) ListUsers(ctx context.Context, arg ListUsersParams) ([]User, error) {
+	query := listUsersSqlite
+	var queryParams []interface{}
+	queryParams = append(queryParams, arg.OrgID)
+	if arg.Name == nil {
+		query = strings.Replace(query, "(/*OPTIONAL:name*/name = ?2)", "(?2 IS NULL)", 1)
+	}
+	queryParams = append(queryParams, arg.Name)
+	if arg.Bio == nil {
+		query = strings.Replace(query, "(/*OPTIONAL:bio*/bio = ?3)", "(?3 IS NULL)", 1)
+	}
+	queryParams = append(queryParams, arg.Bio)
+	if arg.MinAge == nil {
+		query = strings.Replace(query, "(/*OPTIONAL:min_age*/age >= ?4)", "(?4 IS NULL)", 1)
+	}
+	queryParams = append(queryParams, arg.MinAge)
+	rows, err := q.db.QueryContext(ctx, query, queryParams...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []User
+	for rows.Next() {
+		var i User
+		if err := rows.Scan(
+			&i.ID,
+			&i.OrgID,
+			&i.Name,
+			&i.Bio,
+			&i.Age,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
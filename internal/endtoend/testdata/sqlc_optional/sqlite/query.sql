@@ -0,0 +1,15 @@
+-- name: ListUsers :many
+SELECT id, org_id, name, bio, age FROM users
+WHERE org_id = sqlc.arg(org_id)
+  AND sqlc.optional(name = sqlc.arg(name))
+  AND sqlc.optional(bio = sqlc.arg(bio))
+  AND sqlc.optional(age >= sqlc.arg(min_age))
+ORDER BY id;
+
+-- name: CountUsers :one
+SELECT count(*) FROM users
+WHERE sqlc.optional(name LIKE ?);
+
+-- name: DeactivateUsers :execrows
+DELETE FROM users
+WHERE org_id = ? AND sqlc.optional(age < sqlc.arg(max_age));
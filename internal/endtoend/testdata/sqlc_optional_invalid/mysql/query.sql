@@ -0,0 +1,18 @@
+-- name: Or :many
+SELECT id FROM users
+WHERE org_id = ? OR sqlc.optional(name = ?);
+
+-- name: Select :many
+SELECT sqlc.optional(name = ?) FROM users;
+
+-- name: NoParam :many
+SELECT id FROM users
+WHERE sqlc.optional(bio IS NULL);
+
+-- name: TwoParams :many
+SELECT id FROM users
+WHERE sqlc.optional(age BETWEEN ? AND ?);
+
+-- name: Reused :many
+SELECT id FROM users
+WHERE sqlc.optional(name = sqlc.arg(name)) AND bio = sqlc.arg(name);
@@ -0,0 +1,12 @@
+-- name: ListAuthorsByIDs :many chunk=500
+SELECT id, name, country FROM authors WHERE id IN (sqlc.slice(ids));
+
+-- name: ListAuthorsByCountry :many chunk=500
+SELECT id, name FROM authors
+WHERE country = sqlc.arg(country) AND id IN (sqlc.slice(ids));
+
+-- name: DeleteAuthors :exec chunk=1000
+DELETE FROM authors WHERE id IN (sqlc.slice(ids));
+
+-- name: RenameAuthors :execrows chunk=1000
+UPDATE authors SET name = sqlc.arg(name) WHERE id IN (sqlc.slice(ids));
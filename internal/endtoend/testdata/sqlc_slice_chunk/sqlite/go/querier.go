@@ -0,0 +1,42 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.18.0
+
+package querytest
+
+import (
+	"database/sql"
+	"io"
+
+	"context"
+)
+
+type DBTX interface {
+	ExecContext(context.Context, string, ...interface{}) (sql.Result, error)
+	PrepareContext(context.Context, string) (*sql.Stmt, error)
+	QueryContext(context.Context, string, ...interface{}) (*sql.Rows, error)
+	QueryRowContext(context.Context, string, ...interface{}) *sql.Row
+}
+
+type DAL interface {
+	io.Closer
+	DeleteAuthors(ctx context.Context, ids []int64) error
+	ListAuthorsByCountry(ctx context.Context, arg ListAuthorsByCountryParams) ([]ListAuthorsByCountryRow, error)
+	ListAuthorsByIDs(ctx context.Context, ids []int64) ([]Author, error)
+	RenameAuthors(ctx context.Context, arg RenameAuthorsParams) (int64, error)
+}
+
+type ListAuthorsByCountryParams struct {
+	Country string
+	Ids     []int64
+}
+
+type ListAuthorsByCountryRow struct {
+	ID   int64
+	Name string
+}
+
+type RenameAuthorsParams struct {
+	Name string
+	Ids  []int64
+}
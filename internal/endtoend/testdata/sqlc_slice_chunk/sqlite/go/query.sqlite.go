@@ -0,0 +1,190 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.18.0
+// source: query.sql
+
+package querytest
+
+import (
+	"context"
+	"strings"
+)
+
+const deleteAuthorsSqlite = `-- name: DeleteAuthors :exec
+DELETE FROM authors WHERE id IN (/*SLICE:ids*/?)
+`
+
+func (q *SqliteAccess) DeleteAuthors(ctx context.Context, ids []int64) error {
+	if len(ids) > 1000 {
+		for start := 0; start < len(ids); start += 1000 {
+			end := start + 1000
+			if end > len(ids) {
+				end = len(ids)
+			}
+			if err := q.DeleteAuthors(ctx, ids[start:end]); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	query := deleteAuthorsSqlite
+	var queryParams []interface{}
+	if len(ids) > 0 {
+		for _, v := range ids {
+			queryParams = append(queryParams, v)
+		}
+		query = strings.Replace(query, "/*SLICE:ids*/?", strings.Repeat(",?", len(ids))[1:], 1)
+	} else {
+		query = strings.Replace(query, "/*SLICE:ids*/?", "NULL", 1)
+	}
+	_, err := q.db.ExecContext(ctx, query, queryParams...)
+	return err
+}
+
+const listAuthorsByCountrySqlite = `-- name: ListAuthorsByCountry :many
+SELECT id, name FROM authors
+WHERE country = ?1 AND id IN (/*SLICE:ids*/?)
+`
+
+func (q *SqliteAccess) ListAuthorsByCountry(ctx context.Context, arg ListAuthorsByCountryParams) ([]ListAuthorsByCountryRow, error) {
+	if len(arg.Ids) > 500 {
+		var items []ListAuthorsByCountryRow
+		for start := 0; start < len(arg.Ids); start += 500 {
+			end := start + 500
+			if end > len(arg.Ids) {
+				end = len(arg.Ids)
+			}
+			chunk := arg
+			chunk.Ids = arg.Ids[start:end]
+			part, err := q.ListAuthorsByCountry(ctx, chunk)
+			if err != nil {
+				return nil, err
+			}
+			items = append(items, part...)
+		}
+		return items, nil
+	}
+	query := listAuthorsByCountrySqlite
+	var queryParams []interface{}
+	queryParams = append(queryParams, arg.Country)
+	if len(arg.Ids) > 0 {
+		for _, v := range arg.Ids {
+			queryParams = append(queryParams, v)
+		}
+		query = strings.Replace(query, "/*SLICE:ids*/?", strings.Repeat(",?", len(arg.Ids))[1:], 1)
+	} else {
+		query = strings.Replace(query, "/*SLICE:ids*/?", "NULL", 1)
+	}
+	rows, err := q.db.QueryContext(ctx, query, queryParams...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListAuthorsByCountryRow
+	for rows.Next() {
+		var i ListAuthorsByCountryRow
+		if err := rows.Scan(&i.ID, &i.Name); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listAuthorsByIDsSqlite = `-- name: ListAuthorsByIDs :many
+SELECT id, name, country FROM authors WHERE id IN (/*SLICE:ids*/?)
+`
+
+func (q *SqliteAccess) ListAuthorsByIDs(ctx context.Context, ids []int64) ([]Author, error) {
+	if len(ids) > 500 {
+		var items []Author
+		for start := 0; start < len(ids); start += 500 {
+			end := start + 500
+			if end > len(ids) {
+				end = len(ids)
+			}
+			part, err := q.ListAuthorsByIDs(ctx, ids[start:end])
+			if err != nil {
+				return nil, err
+			}
+			items = append(items, part...)
+		}
+		return items, nil
+	}
+	query := listAuthorsByIDsSqlite
+	var queryParams []interface{}
+	if len(ids) > 0 {
+		for _, v := range ids {
+			queryParams = append(queryParams, v)
+		}
+		query = strings.Replace(query, "/*SLICE:ids*/?", strings.Repeat(",?", len(ids))[1:], 1)
+	} else {
+		query = strings.Replace(query, "/*SLICE:ids*/?", "NULL", 1)
+	}
+	rows, err := q.db.QueryContext(ctx, query, queryParams...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Author
+	for rows.Next() {
+		var i Author
+		if err := rows.Scan(&i.ID, &i.Name, &i.Country); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const renameAuthorsSqlite = `-- name: RenameAuthors :execrows
+UPDATE authors SET name = ?1 WHERE id IN (/*SLICE:ids*/?)
+`
+
+func (q *SqliteAccess) RenameAuthors(ctx context.Context, arg RenameAuthorsParams) (int64, error) {
+	if len(arg.Ids) > 1000 {
+		var total int64
+		for start := 0; start < len(arg.Ids); start += 1000 {
+			end := start + 1000
+			if end > len(arg.Ids) {
+				end = len(arg.Ids)
+			}
+			chunk := arg
+			chunk.Ids = arg.Ids[start:end]
+			n, err := q.RenameAuthors(ctx, chunk)
+			if err != nil {
+				return total, err
+			}
+			total += n
+		}
+		return total, nil
+	}
+	query := renameAuthorsSqlite
+	var queryParams []interface{}
+	queryParams = append(queryParams, arg.Name)
+	if len(arg.Ids) > 0 {
+		for _, v := range arg.Ids {
+			queryParams = append(queryParams, v)
+		}
+		query = strings.Replace(query, "/*SLICE:ids*/?", strings.Repeat(",?", len(arg.Ids))[1:], 1)
+	} else {
+		query = strings.Replace(query, "/*SLICE:ids*/?", "NULL", 1)
+	}
+	result, err := q.db.ExecContext(ctx, query, queryParams...)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
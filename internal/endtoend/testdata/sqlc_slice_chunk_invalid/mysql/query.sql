@@ -0,0 +1,26 @@
+-- name: Sorted :many chunk=500
+SELECT id FROM authors WHERE id IN (sqlc.slice(ids)) ORDER BY name;
+
+-- name: Limited :many chunk=500
+SELECT id FROM authors WHERE id IN (sqlc.slice(ids)) LIMIT 10;
+
+-- name: Counted :many chunk=500
+SELECT count(*) FROM authors WHERE id IN (sqlc.slice(ids));
+
+-- name: Grouped :many chunk=500
+SELECT country FROM authors WHERE id IN (sqlc.slice(ids)) GROUP BY country;
+
+-- name: Distinct :many chunk=500
+SELECT DISTINCT country FROM authors WHERE id IN (sqlc.slice(ids));
+
+-- name: NotIn :many chunk=500
+SELECT id FROM authors WHERE id NOT IN (sqlc.slice(ids));
+
+-- name: Either :many chunk=500
+SELECT id FROM authors WHERE id IN (sqlc.slice(ids)) OR country = 'NL';
+
+-- name: Twice :many chunk=500
+SELECT id FROM authors WHERE id IN (sqlc.slice(ids)) AND id IN (sqlc.slice(ids));
+
+-- name: NoSlice :many chunk=500
+SELECT id FROM authors WHERE country = ?;
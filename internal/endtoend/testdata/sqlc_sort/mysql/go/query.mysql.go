@@ -0,0 +1,199 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.18.0
+// source: query.sql
+
+package querytest
+
+import (
+	"context"
+	"strings"
+)
+
+const listPostsMysql = `-- name: ListPosts :many
+SELECT posts.id, posts.title, users.name FROM posts
+JOIN users ON users.id = posts.user_id
+ORDER BY /*SORT:posts.title,users.name*/posts.title ASC
+`
+
+// ListPostsSort is a column MysqlAccess.ListPosts can sort by.
+type ListPostsSort string
+
+const (
+	ListPostsSortTitle ListPostsSort = "title"
+	ListPostsSortName  ListPostsSort = "name"
+)
+
+func (s ListPostsSort) Valid() bool {
+	switch s {
+	case ListPostsSortTitle,
+		ListPostsSortName:
+		return true
+	}
+	return false
+}
+
+// orderBy returns the column to sort by. Values that aren't valid fall back
+// to ListPostsSortTitle, so only known columns end up in the query.
+func (s ListPostsSort) orderBy() string {
+	switch s {
+	case ListPostsSortTitle:
+		return "posts.title"
+	case ListPostsSortName:
+		return "users.name"
+	}
+	return "posts.title"
+}
+
+func (q *MysqlAccess) ListPosts(ctx context.Context, sortBy ListPostsSort, sortDir SortDirection) ([]ListPostsRow, error) {
+	query := listPostsMysql
+	var queryParams []interface{}
+	query = strings.Replace(query, "/*SORT:posts.title,users.name*/posts.title ASC", sortBy.orderBy()+" "+sortDir.sql(), 1)
+	rows, err := q.db.QueryContext(ctx, query, queryParams...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListPostsRow
+	for rows.Next() {
+		var i ListPostsRow
+		if err := rows.Scan(&i.ID, &i.Title, &i.Name); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listUsersMysql = `-- name: ListUsers :many
+SELECT id, name, created_at FROM users
+ORDER BY /*SORT:users.id,users.name,users.created_at*/users.id ASC
+`
+
+// ListUsersSort is a column MysqlAccess.ListUsers can sort by.
+type ListUsersSort string
+
+const (
+	ListUsersSortID        ListUsersSort = "id"
+	ListUsersSortName      ListUsersSort = "name"
+	ListUsersSortCreatedAt ListUsersSort = "created_at"
+)
+
+func (s ListUsersSort) Valid() bool {
+	switch s {
+	case ListUsersSortID,
+		ListUsersSortName,
+		ListUsersSortCreatedAt:
+		return true
+	}
+	return false
+}
+
+// orderBy returns the column to sort by. Values that aren't valid fall back
+// to ListUsersSortID, so only known columns end up in the query.
+func (s ListUsersSort) orderBy() string {
+	switch s {
+	case ListUsersSortID:
+		return "users.id"
+	case ListUsersSortName:
+		return "users.name"
+	case ListUsersSortCreatedAt:
+		return "users.created_at"
+	}
+	return "users.id"
+}
+
+func (q *MysqlAccess) ListUsers(ctx context.Context, sortBy ListUsersSort, sortDir SortDirection) ([]User, error) {
+	query := listUsersMysql
+	var queryParams []interface{}
+	query = strings.Replace(query, "/*SORT:users.id,users.name,users.created_at*/users.id ASC", sortBy.orderBy()+" "+sortDir.sql(), 1)
+	rows, err := q.db.QueryContext(ctx, query, queryParams...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []User
+	for rows.Next() {
+		var i User
+		if err := rows.Scan(&i.ID, &i.Name, &i.CreatedAt); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listUsersByNameMysql = `-- name: ListUsersByName :many
+SELECT id, name, created_at FROM users
+WHERE name LIKE ?
+ORDER BY /*SORT:name,created_at*/name ASC, id
+LIMIT 10
+`
+
+// ListUsersByNameSort is a column MysqlAccess.ListUsersByName can sort by.
+type ListUsersByNameSort string
+
+const (
+	ListUsersByNameSortName      ListUsersByNameSort = "name"
+	ListUsersByNameSortCreatedAt ListUsersByNameSort = "created_at"
+)
+
+func (s ListUsersByNameSort) Valid() bool {
+	switch s {
+	case ListUsersByNameSortName,
+		ListUsersByNameSortCreatedAt:
+		return true
+	}
+	return false
+}
+
+// orderBy returns the column to sort by. Values that aren't valid fall back
+// to ListUsersByNameSortName, so only known columns end up in the query.
+func (s ListUsersByNameSort) orderBy() string {
+	switch s {
+	case ListUsersByNameSortName:
+		return "name"
+	case ListUsersByNameSortCreatedAt:
+		return "created_at"
+	}
+	return "name"
+}
+
+func (q *MysqlAccess) ListUsersByName(ctx context.Context, sortBy ListUsersByNameSort, sortDir SortDirection, name string) ([]User, error) {
+	query := listUsersByNameMysql
+	var queryParams []interface{}
+	queryParams = append(queryParams, name)
+	query = strings.Replace(query, "/*SORT:name,created_at*/name ASC", sortBy.orderBy()+" "+sortDir.sql(), 1)
+	rows, err := q.db.QueryContext(ctx, query, queryParams...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []User
+	for rows.Next() {
+		var i User
+		if err := rows.Scan(&i.ID, &i.Name, &i.CreatedAt); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
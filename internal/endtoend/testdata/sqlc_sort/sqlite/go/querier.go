@@ -0,0 +1,32 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.18.0
+
+package querytest
+
+import (
+	"database/sql"
+	"io"
+
+	"context"
+)
+
+type DBTX interface {
+	ExecContext(context.Context, string, ...interface{}) (sql.Result, error)
+	PrepareContext(context.Context, string) (*sql.Stmt, error)
+	QueryContext(context.Context, string, ...interface{}) (*sql.Rows, error)
+	QueryRowContext(context.Context, string, ...interface{}) *sql.Row
+}
+
+type DAL interface {
+	io.Closer
+	ListPosts(ctx context.Context, sortBy ListPostsSort, sortDir SortDirection) ([]ListPostsRow, error)
+	ListUsers(ctx context.Context, sortBy ListUsersSort, sortDir SortDirection) ([]User, error)
+	ListUsersByName(ctx context.Context, sortBy ListUsersByNameSort, sortDir SortDirection, name string) ([]User, error)
+}
+
+type ListPostsRow struct {
+	ID    int64
+	Title string
+	Name  string
+}
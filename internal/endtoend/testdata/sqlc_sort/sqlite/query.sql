@@ -0,0 +1,14 @@
+-- name: ListUsers :many
+SELECT id, name, created_at FROM users
+ORDER BY sqlc.sort(users);
+
+-- name: ListUsersByName :many
+SELECT id, name, created_at FROM users
+WHERE name LIKE ?
+ORDER BY sqlc.sort('name', 'created_at'), id
+LIMIT 10;
+
+-- name: ListPosts :many
+SELECT posts.id, posts.title, users.name FROM posts
+JOIN users ON users.id = posts.user_id
+ORDER BY sqlc.sort(posts.title, 'users.name');
@@ -0,0 +1,20 @@
+-- name: MissingColumn :many
+SELECT id, name FROM users
+ORDER BY sqlc.sort('name', 'email');
+
+-- name: NotOrderBy :many
+SELECT id, name FROM users
+WHERE sqlc.sort('name') = 1;
+
+-- name: Direction :many
+SELECT id, name FROM users
+ORDER BY sqlc.sort('name', 'id') DESC;
+
+-- name: Twice :many
+SELECT id, name FROM users
+ORDER BY sqlc.sort('name'), sqlc.sort('id');
+
+-- name: Ambiguous :many
+SELECT posts.id, users.name FROM posts
+JOIN users ON users.id = posts.user_id
+ORDER BY sqlc.sort('id', 'name');
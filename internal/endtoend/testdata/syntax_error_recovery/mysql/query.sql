@@ -0,0 +1,11 @@
+/* name: GetUser :one */
+select id, first_name from users where id = ?;
+
+/* name: TooManyFroms :one */
+select id, first_name from users from where id = ?;
+
+/* name: ListUsers :many */
+select id, first_name from users;
+
+/* name: ExtraSelect :one */
+select id from users where select id;
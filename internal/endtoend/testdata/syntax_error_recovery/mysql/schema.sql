@@ -0,0 +1,10 @@
+CREATE TABLE users (
+    id integer NOT NULL AUTO_INCREMENT PRIMARY KEY,
+    first_name varchar(255) NOT NULL
+) ENGINE=InnoDB;
+
+CREATE TABLE posts (
+    id integer NOT NULL AUTO_INCREMENT PRIMARY KEY,
+    user_id integer NOT NULL,
+    title varchar(255) NOT NULL
+) ENGINE=InnoDB;
@@ -0,0 +1,52 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.18.0
+
+package querytest
+
+import (
+	"database/sql"
+	"io"
+
+	"context"
+)
+
+type DBTX interface {
+	ExecContext(context.Context, string, ...interface{}) (sql.Result, error)
+	PrepareContext(context.Context, string) (*sql.Stmt, error)
+	QueryContext(context.Context, string, ...interface{}) (*sql.Rows, error)
+	QueryRowContext(context.Context, string, ...interface{}) *sql.Row
+}
+
+type DAL interface {
+	io.Closer
+	ForeignKeys(ctx context.Context, tableName string) ([]ForeignKeysRow, error)
+	ListTables(ctx context.Context) ([]ListTablesRow, error)
+	TableColumns(ctx context.Context, arg TableColumnsParams) ([]TableColumnsRow, error)
+}
+
+type ForeignKeysRow struct {
+	ConstraintName      sql.NullString
+	ColumnName          sql.NullString
+	ReferencedTableName sql.NullString
+	DeleteRule          string
+}
+
+type ListTablesRow struct {
+	TableName string
+	TableType string
+	TableRows sql.NullInt64
+}
+
+type TableColumnsParams struct {
+	TableSchema string
+	TableName   string
+}
+
+type TableColumnsRow struct {
+	ColumnName      string
+	OrdinalPosition uint32
+	IsNullable      string
+	DataType        sql.NullString
+	ColumnDefault   sql.NullString
+}
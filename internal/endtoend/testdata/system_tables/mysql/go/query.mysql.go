@@ -0,0 +1,109 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.18.0
+// source: query.sql
+
+package querytest
+
+import "context"
+
+const foreignKeysMysql = `-- name: ForeignKeys :many
+SELECT k.constraint_name, k.column_name, k.referenced_table_name, r.delete_rule
+FROM information_schema.key_column_usage k
+JOIN information_schema.referential_constraints r
+  ON r.constraint_schema = k.constraint_schema AND r.constraint_name = k.constraint_name
+WHERE k.table_name = ?
+`
+
+func (q *MysqlAccess) ForeignKeys(ctx context.Context, tableName string) ([]ForeignKeysRow, error) {
+	rows, err := q.db.QueryContext(ctx, foreignKeysMysql, tableName)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ForeignKeysRow
+	for rows.Next() {
+		var i ForeignKeysRow
+		if err := rows.Scan(
+			&i.ConstraintName,
+			&i.ColumnName,
+			&i.ReferencedTableName,
+			&i.DeleteRule,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listTablesMysql = `-- name: ListTables :many
+SELECT table_name, table_type, table_rows
+FROM information_schema.tables
+WHERE table_schema = DATABASE()
+`
+
+func (q *MysqlAccess) ListTables(ctx context.Context) ([]ListTablesRow, error) {
+	rows, err := q.db.QueryContext(ctx, listTablesMysql)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListTablesRow
+	for rows.Next() {
+		var i ListTablesRow
+		if err := rows.Scan(&i.TableName, &i.TableType, &i.TableRows); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const tableColumnsMysql = `-- name: TableColumns :many
+SELECT column_name, ordinal_position, is_nullable, data_type, column_default
+FROM information_schema.columns
+WHERE table_schema = ? AND table_name = ?
+ORDER BY ordinal_position
+`
+
+func (q *MysqlAccess) TableColumns(ctx context.Context, arg TableColumnsParams) ([]TableColumnsRow, error) {
+	rows, err := q.db.QueryContext(ctx, tableColumnsMysql, arg.TableSchema, arg.TableName)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []TableColumnsRow
+	for rows.Next() {
+		var i TableColumnsRow
+		if err := rows.Scan(
+			&i.ColumnName,
+			&i.OrdinalPosition,
+			&i.IsNullable,
+			&i.DataType,
+			&i.ColumnDefault,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
@@ -0,0 +1,17 @@
+-- name: ListTables :many
+SELECT table_name, table_type, table_rows
+FROM information_schema.tables
+WHERE table_schema = DATABASE();
+
+-- name: TableColumns :many
+SELECT column_name, ordinal_position, is_nullable, data_type, column_default
+FROM information_schema.columns
+WHERE table_schema = ? AND table_name = ?
+ORDER BY ordinal_position;
+
+-- name: ForeignKeys :many
+SELECT k.constraint_name, k.column_name, k.referenced_table_name, r.delete_rule
+FROM information_schema.key_column_usage k
+JOIN information_schema.referential_constraints r
+  ON r.constraint_schema = k.constraint_schema AND r.constraint_name = k.constraint_name
+WHERE k.table_name = ?;
@@ -0,0 +1,4 @@
+CREATE TABLE authors (
+    id BIGINT NOT NULL AUTO_INCREMENT PRIMARY KEY,
+    name TEXT NOT NULL
+);
@@ -0,0 +1,55 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.18.0
+
+package querytest
+
+import (
+	"database/sql"
+	"io"
+
+	"context"
+)
+
+type DBTX interface {
+	ExecContext(context.Context, string, ...interface{}) (sql.Result, error)
+	PrepareContext(context.Context, string) (*sql.Stmt, error)
+	QueryContext(context.Context, string, ...interface{}) (*sql.Rows, error)
+	QueryRowContext(context.Context, string, ...interface{}) *sql.Row
+}
+
+type DAL interface {
+	io.Closer
+	ForeignKeys(ctx context.Context) ([]ForeignKeysRow, error)
+	ListTables(ctx context.Context) ([]ListTablesRow, error)
+	TableColumns(ctx context.Context, pragmaTableInfo string) ([]TableColumnsRow, error)
+	TableColumnsAll(ctx context.Context) ([]TableColumnsAllRow, error)
+}
+
+type ForeignKeysRow struct {
+	ID       int64
+	Seq      int64
+	OnDelete string
+}
+
+type ListTablesRow struct {
+	Name string
+	Sql  sql.NullString
+}
+
+type TableColumnsRow struct {
+	Cid       int64
+	Name      string
+	Type      string
+	DfltValue sql.NullString
+	Pk        int64
+}
+
+type TableColumnsAllRow struct {
+	Cid       int64
+	Name      string
+	Type      string
+	Notnull   int64
+	DfltValue sql.NullString
+	Pk        int64
+}
@@ -0,0 +1,129 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.18.0
+// source: query.sql
+
+package querytest
+
+import "context"
+
+const foreignKeysSqlite = `-- name: ForeignKeys :many
+SELECT fk.id, fk.seq, fk.on_delete FROM pragma_foreign_key_list('authors') AS fk
+`
+
+func (q *SqliteAccess) ForeignKeys(ctx context.Context) ([]ForeignKeysRow, error) {
+	rows, err := q.db.QueryContext(ctx, foreignKeysSqlite)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ForeignKeysRow
+	for rows.Next() {
+		var i ForeignKeysRow
+		if err := rows.Scan(&i.ID, &i.Seq, &i.OnDelete); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listTablesSqlite = `-- name: ListTables :many
+SELECT name, sql FROM sqlite_master WHERE type = 'table'
+`
+
+func (q *SqliteAccess) ListTables(ctx context.Context) ([]ListTablesRow, error) {
+	rows, err := q.db.QueryContext(ctx, listTablesSqlite)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListTablesRow
+	for rows.Next() {
+		var i ListTablesRow
+		if err := rows.Scan(&i.Name, &i.Sql); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const tableColumnsSqlite = `-- name: TableColumns :many
+SELECT cid, name, type, dflt_value, pk FROM pragma_table_info(?)
+`
+
+func (q *SqliteAccess) TableColumns(ctx context.Context, pragmaTableInfo string) ([]TableColumnsRow, error) {
+	rows, err := q.db.QueryContext(ctx, tableColumnsSqlite, pragmaTableInfo)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []TableColumnsRow
+	for rows.Next() {
+		var i TableColumnsRow
+		if err := rows.Scan(
+			&i.Cid,
+			&i.Name,
+			&i.Type,
+			&i.DfltValue,
+			&i.Pk,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const tableColumnsAllSqlite = `-- name: TableColumnsAll :many
+SELECT cid, name, type, "notnull", dflt_value, pk FROM pragma_table_info('authors')
+`
+
+func (q *SqliteAccess) TableColumnsAll(ctx context.Context) ([]TableColumnsAllRow, error) {
+	rows, err := q.db.QueryContext(ctx, tableColumnsAllSqlite)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []TableColumnsAllRow
+	for rows.Next() {
+		var i TableColumnsAllRow
+		if err := rows.Scan(
+			&i.Cid,
+			&i.Name,
+			&i.Type,
+			&i.Notnull,
+			&i.DfltValue,
+			&i.Pk,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
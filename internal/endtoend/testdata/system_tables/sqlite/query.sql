@@ -0,0 +1,11 @@
+-- name: ListTables :many
+SELECT name, sql FROM sqlite_master WHERE type = 'table';
+
+-- name: TableColumns :many
+SELECT cid, name, type, dflt_value, pk FROM pragma_table_info(?);
+
+-- name: TableColumnsAll :many
+SELECT * FROM pragma_table_info('authors');
+
+-- name: ForeignKeys :many
+SELECT fk.id, fk.seq, fk.on_delete FROM pragma_foreign_key_list('authors') AS fk;
@@ -0,0 +1,193 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.18.0
+
+package querytest
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/go-sql-driver/mysql"
+)
+
+func NewMysql(db DBTX) *MysqlAccess {
+	return &MysqlAccess{db: db}
+}
+
+func PrepareMysql(ctx context.Context, db DBTX) (*MysqlAccess, error) {
+	q := MysqlAccess{db: db}
+	var err error
+	if q.addToBalanceStmt, err = db.PrepareContext(ctx, addToBalanceMysql); err != nil {
+		return nil, fmt.Errorf("error preparing query AddToBalance: %w", err)
+	}
+	if q.createAccountStmt, err = db.PrepareContext(ctx, createAccountMysql); err != nil {
+		return nil, fmt.Errorf("error preparing query CreateAccount: %w", err)
+	}
+	if q.getAccountStmt, err = db.PrepareContext(ctx, getAccountMysql); err != nil {
+		return nil, fmt.Errorf("error preparing query GetAccount: %w", err)
+	}
+	return &q, nil
+}
+
+func (q *MysqlAccess) Close() error {
+	var err error
+	if q.addToBalanceStmt != nil {
+		if cerr := q.addToBalanceStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing addToBalanceStmt: %w", cerr)
+		}
+	}
+	if q.createAccountStmt != nil {
+		if cerr := q.createAccountStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing createAccountStmt: %w", cerr)
+		}
+	}
+	if q.getAccountStmt != nil {
+		if cerr := q.getAccountStmt.Close(); cerr != nil {
+			err = fmt.Errorf("error closing getAccountStmt: %w", cerr)
+		}
+	}
+	return err
+}
+
+func (q *MysqlAccess) exec(ctx context.Context, stmt *sql.Stmt, query string, args ...interface{}) (sql.Result, error) {
+	switch {
+	case stmt != nil && q.tx != nil:
+		return q.tx.StmtContext(ctx, stmt).ExecContext(ctx, args...)
+	case stmt != nil:
+		return stmt.ExecContext(ctx, args...)
+	default:
+		return q.db.ExecContext(ctx, query, args...)
+	}
+}
+
+func (q *MysqlAccess) query(ctx context.Context, stmt *sql.Stmt, query string, args ...interface{}) (*sql.Rows, error) {
+	switch {
+	case stmt != nil && q.tx != nil:
+		return q.tx.StmtContext(ctx, stmt).QueryContext(ctx, args...)
+	case stmt != nil:
+		return stmt.QueryContext(ctx, args...)
+	default:
+		return q.db.QueryContext(ctx, query, args...)
+	}
+}
+
+func (q *MysqlAccess) queryRow(ctx context.Context, stmt *sql.Stmt, query string, args ...interface{}) *sql.Row {
+	switch {
+	case stmt != nil && q.tx != nil:
+		return q.tx.StmtContext(ctx, stmt).QueryRowContext(ctx, args...)
+	case stmt != nil:
+		return stmt.QueryRowContext(ctx, args...)
+	default:
+		return q.db.QueryRowContext(ctx, query, args...)
+	}
+}
+
+type MysqlAccess struct {
+	db                DBTX
+	tx                *sql.Tx
+	addToBalanceStmt  *sql.Stmt
+	createAccountStmt *sql.Stmt
+	getAccountStmt    *sql.Stmt
+	savepoint         int
+}
+
+func (q *MysqlAccess) WithTx(tx *sql.Tx) *MysqlAccess {
+	return &MysqlAccess{
+		db:                tx,
+		tx:                tx,
+		addToBalanceStmt:  q.addToBalanceStmt,
+		createAccountStmt: q.createAccountStmt,
+		getAccountStmt:    q.getAccountStmt,
+	}
+}
+
+// RunInTx runs fn with a MysqlAccess using a transaction. The transaction
+// is committed when fn returns nil, and rolled back when it returns an error or
+// panics. A transaction failing on a deadlock or a serialization failure is run
+// again, up to 3 times in all, so fn may be called more than once.
+//
+// Called on a MysqlAccess already using a transaction, RunInTx runs fn in a
+// savepoint instead and opts is ignored. An error then only rolls back what fn
+// did, and is returned for the caller to handle.
+func (q *MysqlAccess) RunInTx(ctx context.Context, opts *sql.TxOptions, fn func(q *MysqlAccess) error) error {
+	if tx, ok := q.db.(*sql.Tx); ok {
+		return q.runInSavepoint(ctx, tx, fn)
+	}
+	db, ok := q.db.(interface {
+		BeginTx(context.Context, *sql.TxOptions) (*sql.Tx, error)
+	})
+	if !ok {
+		return errors.New("RunInTx: db can't begin a transaction")
+	}
+	const maxAttempts = 3
+	var err error
+	for attempt := 0; attempt < maxAttempts; attempt++ {
+		err = q.runInTx(ctx, db.BeginTx, opts, fn)
+		if err == nil || !q.retryable(err) || ctx.Err() != nil {
+			break
+		}
+	}
+	return err
+}
+
+func (q *MysqlAccess) runInTx(ctx context.Context, begin func(context.Context, *sql.TxOptions) (*sql.Tx, error), opts *sql.TxOptions, fn func(q *MysqlAccess) error) error {
+	tx, err := begin(ctx, opts)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if p := recover(); p != nil {
+			tx.Rollback()
+			panic(p)
+		}
+	}()
+	if err := fn(q.WithTx(tx)); err != nil {
+		tx.Rollback()
+		return err
+	}
+	return tx.Commit()
+}
+
+func (q *MysqlAccess) runInSavepoint(ctx context.Context, tx *sql.Tx, fn func(q *MysqlAccess) error) error {
+	nested := q.WithTx(tx)
+	nested.savepoint = q.savepoint + 1
+	name := fmt.Sprintf("sqlc_savepoint_%d", nested.savepoint)
+	if _, err := tx.ExecContext(ctx, "SAVEPOINT "+name); err != nil {
+		return err
+	}
+	rollback := func() {
+		tx.ExecContext(ctx, "ROLLBACK TO SAVEPOINT "+name)
+		tx.ExecContext(ctx, "RELEASE SAVEPOINT "+name)
+	}
+	defer func() {
+		if p := recover(); p != nil {
+			rollback()
+			panic(p)
+		}
+	}()
+	if err := fn(nested); err != nil {
+		rollback()
+		return err
+	}
+	_, err := tx.ExecContext(ctx, "RELEASE SAVEPOINT "+name)
+	return err
+}
+
+// retryable reports whether err is a deadlock or a serialization failure,
+// after which the transaction can be run again
+func (q *MysqlAccess) retryable(err error) bool {
+	// ER_LOCK_DEADLOCK, also returned for serialization failures
+	var merr *mysql.MySQLError
+	return errors.As(err, &merr) && merr.Number == 1213
+}
+
+// RunInTxDAL is RunInTx for code using the DAL interface. fn must not close the
+// DAL it's given.
+func (q *MysqlAccess) RunInTxDAL(ctx context.Context, opts *sql.TxOptions, fn func(DAL) error) error {
+	return q.RunInTx(ctx, opts, func(q *MysqlAccess) error {
+		return fn(q)
+	})
+}
@@ -0,0 +1,39 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.18.0
+
+package querytest
+
+import (
+	"database/sql"
+	"io"
+
+	"context"
+)
+
+type DBTX interface {
+	ExecContext(context.Context, string, ...interface{}) (sql.Result, error)
+	PrepareContext(context.Context, string) (*sql.Stmt, error)
+	QueryContext(context.Context, string, ...interface{}) (*sql.Rows, error)
+	QueryRowContext(context.Context, string, ...interface{}) *sql.Row
+}
+
+type DAL interface {
+	io.Closer
+	AddToBalance(ctx context.Context, arg AddToBalanceParams) error
+	CreateAccount(ctx context.Context, arg CreateAccountParams) error
+	GetAccount(ctx context.Context, id int64) (Account, error)
+	// RunInTxDAL runs fn with a DAL using a transaction. See RunInTx for
+	// how it's committed, rolled back, retried and nested.
+	RunInTxDAL(ctx context.Context, opts *sql.TxOptions, fn func(DAL) error) error
+}
+
+type AddToBalanceParams struct {
+	Balance int64
+	ID      int64
+}
+
+type CreateAccountParams struct {
+	Owner   string
+	Balance int64
+}
@@ -0,0 +1,39 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.18.0
+// source: query.sql
+
+package querytest
+
+import "context"
+
+const addToBalanceMysql = `-- name: AddToBalance :exec
+UPDATE accounts SET balance = balance + ?
+WHERE id = ?
+`
+
+func (q *MysqlAccess) AddToBalance(ctx context.Context, arg AddToBalanceParams) error {
+	_, err := q.exec(ctx, q.addToBalanceStmt, addToBalanceMysql, arg.Balance, arg.ID)
+	return err
+}
+
+const createAccountMysql = `-- name: CreateAccount :exec
+INSERT INTO accounts (owner, balance) VALUES (?, ?)
+`
+
+func (q *MysqlAccess) CreateAccount(ctx context.Context, arg CreateAccountParams) error {
+	_, err := q.exec(ctx, q.createAccountStmt, createAccountMysql, arg.Owner, arg.Balance)
+	return err
+}
+
+const getAccountMysql = `-- name: GetAccount :one
+SELECT id, owner, balance FROM accounts
+WHERE id = ?
+`
+
+func (q *MysqlAccess) GetAccount(ctx context.Context, id int64) (Account, error) {
+	row := q.queryRow(ctx, q.getAccountStmt, getAccountMysql, id)
+	var i Account
+	err := row.Scan(&i.ID, &i.Owner, &i.Balance)
+	return i, err
+}
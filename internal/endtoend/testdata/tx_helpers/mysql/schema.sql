@@ -0,0 +1,5 @@
+CREATE TABLE accounts (
+  id      BIGINT PRIMARY KEY AUTO_INCREMENT,
+  owner   TEXT   NOT NULL,
+  balance BIGINT NOT NULL
+);
@@ -0,0 +1,121 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.18.0
+
+package querytest
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+func NewSqlite(db DBTX) *SqliteAccess {
+	return &SqliteAccess{db: db}
+}
+
+type SqliteAccess struct {
+	db        DBTX
+	savepoint int
+}
+
+// Close lets SqliteAccess implement DAL. It has no prepared statements to
+// close.
+func (q *SqliteAccess) Close() error {
+	return nil
+}
+
+func (q *SqliteAccess) WithTx(tx *sql.Tx) *SqliteAccess {
+	return &SqliteAccess{
+		db: tx,
+	}
+}
+
+// RunInTx runs fn with a SqliteAccess using a transaction. The transaction
+// is committed when fn returns nil, and rolled back when it returns an error or
+// panics. A transaction failing on a deadlock or a serialization failure is run
+// again, up to 3 times in all, so fn may be called more than once.
+//
+// Called on a SqliteAccess already using a transaction, RunInTx runs fn in a
+// savepoint instead and opts is ignored. An error then only rolls back what fn
+// did, and is returned for the caller to handle.
+func (q *SqliteAccess) RunInTx(ctx context.Context, opts *sql.TxOptions, fn func(q *SqliteAccess) error) error {
+	if tx, ok := q.db.(*sql.Tx); ok {
+		return q.runInSavepoint(ctx, tx, fn)
+	}
+	db, ok := q.db.(interface {
+		BeginTx(context.Context, *sql.TxOptions) (*sql.Tx, error)
+	})
+	if !ok {
+		return errors.New("RunInTx: db can't begin a transaction")
+	}
+	const maxAttempts = 3
+	var err error
+	for attempt := 0; attempt < maxAttempts; attempt++ {
+		err = q.runInTx(ctx, db.BeginTx, opts, fn)
+		if err == nil || !q.retryable(err) || ctx.Err() != nil {
+			break
+		}
+	}
+	return err
+}
+
+func (q *SqliteAccess) runInTx(ctx context.Context, begin func(context.Context, *sql.TxOptions) (*sql.Tx, error), opts *sql.TxOptions, fn func(q *SqliteAccess) error) error {
+	tx, err := begin(ctx, opts)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if p := recover(); p != nil {
+			tx.Rollback()
+			panic(p)
+		}
+	}()
+	if err := fn(q.WithTx(tx)); err != nil {
+		tx.Rollback()
+		return err
+	}
+	return tx.Commit()
+}
+
+func (q *SqliteAccess) runInSavepoint(ctx context.Context, tx *sql.Tx, fn func(q *SqliteAccess) error) error {
+	nested := q.WithTx(tx)
+	nested.savepoint = q.savepoint + 1
+	name := fmt.Sprintf("sqlc_savepoint_%d", nested.savepoint)
+	if _, err := tx.ExecContext(ctx, "SAVEPOINT "+name); err != nil {
+		return err
+	}
+	rollback := func() {
+		tx.ExecContext(ctx, "ROLLBACK TO SAVEPOINT "+name)
+		tx.ExecContext(ctx, "RELEASE SAVEPOINT "+name)
+	}
+	defer func() {
+		if p := recover(); p != nil {
+			rollback()
+			panic(p)
+		}
+	}()
+	if err := fn(nested); err != nil {
+		rollback()
+		return err
+	}
+	_, err := tx.ExecContext(ctx, "RELEASE SAVEPOINT "+name)
+	return err
+}
+
+// retryable reports whether err is a deadlock or a serialization failure,
+// after which the transaction can be run again
+func (q *SqliteAccess) retryable(err error) bool {
+	// SQLITE_BUSY, which the drivers don't share an error type for
+	return strings.Contains(err.Error(), "database is locked")
+}
+
+// RunInTxDAL is RunInTx for code using the DAL interface. fn must not close the
+// DAL it's given.
+func (q *SqliteAccess) RunInTxDAL(ctx context.Context, opts *sql.TxOptions, fn func(DAL) error) error {
+	return q.RunInTx(ctx, opts, func(q *SqliteAccess) error {
+		return fn(q)
+	})
+}
@@ -0,0 +1,39 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.18.0
+// source: query.sql
+
+package querytest
+
+import "context"
+
+const addToBalanceSqlite = `-- name: AddToBalance :exec
+UPDATE accounts SET balance = balance + ?
+WHERE id = ?
+`
+
+func (q *SqliteAccess) AddToBalance(ctx context.Context, arg AddToBalanceParams) error {
+	_, err := q.db.ExecContext(ctx, addToBalanceSqlite, arg.Balance, arg.ID)
+	return err
+}
+
+const createAccountSqlite = `-- name: CreateAccount :exec
+INSERT INTO accounts (owner, balance) VALUES (?, ?)
+`
+
+func (q *SqliteAccess) CreateAccount(ctx context.Context, arg CreateAccountParams) error {
+	_, err := q.db.ExecContext(ctx, createAccountSqlite, arg.Owner, arg.Balance)
+	return err
+}
+
+const getAccountSqlite = `-- name: GetAccount :one
+SELECT id, owner, balance FROM accounts
+WHERE id = ?
+`
+
+func (q *SqliteAccess) GetAccount(ctx context.Context, id int64) (Account, error) {
+	row := q.db.QueryRowContext(ctx, getAccountSqlite, id)
+	var i Account
+	err := row.Scan(&i.ID, &i.Owner, &i.Balance)
+	return i, err
+}
@@ -0,0 +1,10 @@
+-- name: GetAccount :one
+SELECT id, owner, balance FROM accounts
+WHERE id = ?;
+
+-- name: CreateAccount :exec
+INSERT INTO accounts (owner, balance) VALUES (?, ?);
+
+-- name: AddToBalance :exec
+UPDATE accounts SET balance = balance + ?
+WHERE id = ?;
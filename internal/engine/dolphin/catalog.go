@@ -10,6 +10,7 @@ func NewCatalog() *catalog.Catalog {
 		DefaultSchema: def,
 		Schemas: []*catalog.Schema{
 			defaultSchema(def),
+			informationSchema(),
 		},
 		Extensions: map[string]struct{}{},
 	}
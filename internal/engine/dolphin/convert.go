@@ -39,25 +39,18 @@ func (c *cc) convertAlterTableStmt(n *pcast.AlterTableStmt) ast.Node {
 		Table: parseTableName(n.Table),
 		Cmds:  &ast.List{},
 	}
+	// Indexes and comments aren't changed by table commands, so they are
+	// returned as separate statements applied after the table is altered
+	var stmts []ast.Node
 	for _, spec := range n.Specs {
 		switch spec.Tp {
 		case pcast.AlterTableAddColumns:
 			for _, def := range spec.NewColumns {
 				name := def.Name.String()
-				columnDef := ast.ColumnDef{
-					Colname:    def.Name.String(),
-					TypeName:   &ast.TypeName{Name: types.TypeToStr(def.Tp.GetType(), def.Tp.GetCharset())},
-					IsNotNull:  isNotNull(def),
-					IsUnsigned: isUnsigned(def),
-				}
-				if def.Tp.GetFlen() >= 0 {
-					length := def.Tp.GetFlen()
-					columnDef.Length = &length
-				}
 				alt.Cmds.Items = append(alt.Cmds.Items, &ast.AlterTableCmd{
 					Name:    &name,
 					Subtype: ast.AT_AddColumn,
-					Def:     &columnDef,
+					Def:     columnDef(def),
 				})
 			}
 
@@ -78,36 +71,16 @@ func (c *cc) convertAlterTableStmt(n *pcast.AlterTableStmt) ast.Node {
 
 			for _, def := range spec.NewColumns {
 				name := def.Name.String()
-				columnDef := ast.ColumnDef{
-					Colname:    def.Name.String(),
-					TypeName:   &ast.TypeName{Name: types.TypeToStr(def.Tp.GetType(), def.Tp.GetCharset())},
-					IsNotNull:  isNotNull(def),
-					IsUnsigned: isUnsigned(def),
-				}
-				if def.Tp.GetFlen() >= 0 {
-					length := def.Tp.GetFlen()
-					columnDef.Length = &length
-				}
 				alt.Cmds.Items = append(alt.Cmds.Items, &ast.AlterTableCmd{
 					Name:    &name,
 					Subtype: ast.AT_AddColumn,
-					Def:     &columnDef,
+					Def:     columnDef(def),
 				})
 			}
 
 		case pcast.AlterTableModifyColumn:
 			for _, def := range spec.NewColumns {
 				name := def.Name.String()
-				columnDef := ast.ColumnDef{
-					Colname:    def.Name.String(),
-					TypeName:   &ast.TypeName{Name: types.TypeToStr(def.Tp.GetType(), def.Tp.GetCharset())},
-					IsNotNull:  isNotNull(def),
-					IsUnsigned: isUnsigned(def),
-				}
-				if def.Tp.GetFlen() >= 0 {
-					length := def.Tp.GetFlen()
-					columnDef.Length = &length
-				}
 				alt.Cmds.Items = append(alt.Cmds.Items, &ast.AlterTableCmd{
 					Name:    &name,
 					Subtype: ast.AT_DropColumn,
@@ -115,7 +88,7 @@ func (c *cc) convertAlterTableStmt(n *pcast.AlterTableStmt) ast.Node {
 				alt.Cmds.Items = append(alt.Cmds.Items, &ast.AlterTableCmd{
 					Name:    &name,
 					Subtype: ast.AT_AddColumn,
-					Def:     &columnDef,
+					Def:     columnDef(def),
 				})
 			}
 
@@ -123,7 +96,27 @@ func (c *cc) convertAlterTableStmt(n *pcast.AlterTableStmt) ast.Node {
 			// 	spew.Dump("alter column", spec)
 
 		case pcast.AlterTableAddConstraint:
-			// 	spew.Dump("add const", spec)
+			if idx := constraintIndex(n.Table, spec.Constraint); idx != nil {
+				stmts = append(stmts, idx)
+			}
+
+		case pcast.AlterTableDropIndex:
+			stmts = append(stmts, &ast.DropIndexStmt{
+				IfExists: spec.IfExists,
+				Name:     identifier(spec.Name),
+				Table:    parseTableName(n.Table),
+			})
+
+		case pcast.AlterTableOption:
+			for _, opt := range spec.Options {
+				if opt.Tp == pcast.TableOptionComment {
+					comment := opt.StrValue
+					stmts = append(stmts, &ast.CommentOnTableStmt{
+						Table:   parseTableName(n.Table),
+						Comment: &comment,
+					})
+				}
+			}
 
 		case pcast.AlterTableRenameColumn:
 			// TODO: Returning here may be incorrect if there are multiple specs
@@ -149,6 +142,9 @@ func (c *cc) convertAlterTableStmt(n *pcast.AlterTableStmt) ast.Node {
 			continue
 		}
 	}
+	if len(stmts) > 0 {
+		return &ast.List{Items: append([]ast.Node{alt}, stmts...)}
+	}
 	return alt
 }
 
@@ -218,8 +214,12 @@ func opToName(o opcode.Op) string {
 
 func (c *cc) convertBinaryOperationExpr(n *pcast.BinaryOperationExpr) ast.Node {
 	if n.Op == opcode.LogicAnd || n.Op == opcode.LogicOr {
+		op := ast.BoolExprTypeAnd
+		if n.Op == opcode.LogicOr {
+			op = ast.BoolExprTypeOr
+		}
 		return &ast.BoolExpr{
-			// TODO: Set op
+			Boolop: op,
 			Args: &ast.List{
 				Items: []ast.Node{
 					c.convert(n.L),
@@ -250,37 +250,26 @@ func (c *cc) convertCreateTableStmt(n *pcast.CreateTableStmt) ast.Node {
 		create.ReferTable = parseTableName(n.ReferTable)
 	}
 	for _, def := range n.Cols {
-		var vals *ast.List
-		if len(def.Tp.GetElems()) > 0 {
-			vals = &ast.List{}
-			for i := range def.Tp.GetElems() {
-				vals.Items = append(vals.Items, &ast.String{
-					Str: def.Tp.GetElems()[i],
-				})
-			}
-		}
-		comment := ""
+		create.Cols = append(create.Cols, columnDef(def))
 		for _, opt := range def.Options {
-			switch opt.Tp {
-			case pcast.ColumnOptionComment:
-				if value, ok := opt.Expr.(*driver.ValueExpr); ok {
-					comment = value.GetString()
-				}
+			if opt.Tp == pcast.ColumnOptionUniqKey {
+				create.Indexes = append(create.Indexes, indexStmt(n.Table, "", []string{def.Name.String()}, true))
 			}
 		}
-		columnDef := ast.ColumnDef{
-			Colname:    def.Name.String(),
-			TypeName:   &ast.TypeName{Name: types.TypeToStr(def.Tp.GetType(), def.Tp.GetCharset())},
-			IsNotNull:  isNotNull(def),
-			IsUnsigned: isUnsigned(def),
-			Comment:    comment,
-			Vals:       vals,
+	}
+	for _, constraint := range n.Constraints {
+		if idx := constraintIndex(n.Table, constraint); idx != nil {
+			create.Indexes = append(create.Indexes, idx)
 		}
-		if def.Tp.GetFlen() >= 0 {
-			length := def.Tp.GetFlen()
-			columnDef.Length = &length
+		if constraint.Tp == pcast.ConstraintPrimaryKey {
+			for _, name := range indexColumns(constraint.Keys) {
+				for _, col := range create.Cols {
+					if col.Colname == name {
+						col.IsPrimaryKey = true
+					}
+				}
+			}
 		}
-		create.Cols = append(create.Cols, &columnDef)
 	}
 	for _, opt := range n.Options {
 		switch opt.Tp {
@@ -291,6 +280,91 @@ func (c *cc) convertCreateTableStmt(n *pcast.CreateTableStmt) ast.Node {
 	return create
 }
 
+func columnDef(def *pcast.ColumnDef) *ast.ColumnDef {
+	var vals *ast.List
+	if len(def.Tp.GetElems()) > 0 {
+		vals = &ast.List{}
+		for i := range def.Tp.GetElems() {
+			vals.Items = append(vals.Items, &ast.String{
+				Str: def.Tp.GetElems()[i],
+			})
+		}
+	}
+	comment := ""
+	autoIncrement := false
+	dflt := ""
+	for _, opt := range def.Options {
+		switch opt.Tp {
+		case pcast.ColumnOptionComment:
+			if value, ok := opt.Expr.(*driver.ValueExpr); ok {
+				comment = value.GetString()
+			}
+		case pcast.ColumnOptionAutoIncrement:
+			autoIncrement = true
+		case pcast.ColumnOptionDefaultValue:
+			dflt = defaultValue(opt.Expr)
+		}
+	}
+	columnDef := &ast.ColumnDef{
+		Colname:         def.Name.String(),
+		TypeName:        &ast.TypeName{Name: types.TypeToStr(def.Tp.GetType(), def.Tp.GetCharset())},
+		IsNotNull:       isNotNull(def),
+		IsUnsigned:      isUnsigned(def),
+		IsPrimaryKey:    isPrimaryKey(def),
+		IsAutoIncrement: autoIncrement,
+		Default:         dflt,
+		Comment:         comment,
+		Vals:            vals,
+	}
+	if def.Tp.GetFlen() >= 0 {
+		length := def.Tp.GetFlen()
+		columnDef.Length = &length
+	}
+	return columnDef
+}
+
+func indexStmt(table *pcast.TableName, name string, cols []string, unique bool) *ast.IndexStmt {
+	rel := parseTableName(table)
+	idx := &ast.IndexStmt{
+		Relation:    &ast.RangeVar{Relname: &rel.Name},
+		IndexParams: &ast.List{},
+		Unique:      unique,
+	}
+	if rel.Schema != "" {
+		idx.Relation.Schemaname = &rel.Schema
+	}
+	if name != "" {
+		name = identifier(name)
+		idx.Idxname = &name
+	}
+	for i := range cols {
+		idx.IndexParams.Items = append(idx.IndexParams.Items, &ast.IndexElem{Name: &cols[i]})
+	}
+	return idx
+}
+
+func indexColumns(keys []*pcast.IndexPartSpecification) []string {
+	var cols []string
+	for _, key := range keys {
+		if key.Column != nil {
+			cols = append(cols, key.Column.Name.String())
+		}
+	}
+	return cols
+}
+
+// constraintIndex returns the index created by a table constraint, if any.
+// Primary keys aren't tracked as indexes.
+func constraintIndex(table *pcast.TableName, n *pcast.Constraint) *ast.IndexStmt {
+	switch n.Tp {
+	case pcast.ConstraintKey, pcast.ConstraintIndex:
+		return indexStmt(table, n.Name, indexColumns(n.Keys), false)
+	case pcast.ConstraintUniq, pcast.ConstraintUniqKey, pcast.ConstraintUniqIndex:
+		return indexStmt(table, n.Name, indexColumns(n.Keys), true)
+	}
+	return nil
+}
+
 func (c *cc) convertColumnNameExpr(n *pcast.ColumnNameExpr) *ast.ColumnRef {
 	var items []ast.Node
 	if schema := n.Name.Schema.String(); schema != "" {
@@ -797,7 +871,9 @@ func (c *cc) convertCreateDatabaseStmt(n *pcast.CreateDatabaseStmt) ast.Node {
 }
 
 func (c *cc) convertCreateIndexStmt(n *pcast.CreateIndexStmt) ast.Node {
-	return todo(n)
+	idx := indexStmt(n.Table, n.IndexName, indexColumns(n.IndexPartSpecifications), n.KeyType == pcast.IndexKeyTypeUnique)
+	idx.IfNotExists = n.IfNotExists
+	return idx
 }
 
 func (c *cc) convertCreateSequenceStmt(n *pcast.CreateSequenceStmt) ast.Node {
@@ -853,7 +929,11 @@ func (c *cc) convertDropDatabaseStmt(n *pcast.DropDatabaseStmt) ast.Node {
 }
 
 func (c *cc) convertDropIndexStmt(n *pcast.DropIndexStmt) ast.Node {
-	return todo(n)
+	return &ast.DropIndexStmt{
+		IfExists: n.IfExists,
+		Name:     identifier(n.IndexName),
+		Table:    parseTableName(n.Table),
+	}
 }
 
 func (c *cc) convertDropSequenceStmt(n *pcast.DropSequenceStmt) ast.Node {
@@ -1043,7 +1123,7 @@ func (c *cc) convertOrderByClause(n *pcast.OrderByClause) ast.Node {
 				Expr:     c.convert(item.Expr),
 				Location: item.Expr.OriginTextPosition(),
 			})
-		case *pcast.ColumnNameExpr:
+		case *pcast.ColumnNameExpr, *pcast.FuncCallExpr:
 			list.Items = append(list.Items, &ast.CaseExpr{
 				Xpr:      c.convert(item.Expr),
 				Location: item.Expr.OriginTextPosition(),
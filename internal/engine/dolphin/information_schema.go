@@ -0,0 +1,164 @@
+package dolphin
+
+import (
+	"github.com/ZeyuRemtes/sqlc/internal/sql/ast"
+	"github.com/ZeyuRemtes/sqlc/internal/sql/catalog"
+)
+
+// The subset of information_schema that is useful for introspection queries.
+// Column definitions follow MySQL 8.0:
+//
+//	https://dev.mysql.com/doc/refman/8.0/en/information-schema-introduction.html
+
+type column struct {
+	name     string
+	typ      string
+	notNull  bool
+	unsigned bool
+}
+
+func informationSchemaTable(name string, cols []column) *catalog.Table {
+	t := &catalog.Table{
+		Rel:      &ast.TableName{Schema: "information_schema", Name: name},
+		IsSystem: true,
+	}
+	for _, c := range cols {
+		t.Columns = append(t.Columns, &catalog.Column{
+			Name:       c.name,
+			Type:       ast.TypeName{Name: c.typ},
+			IsNotNull:  c.notNull,
+			IsUnsigned: c.unsigned,
+		})
+	}
+	return t
+}
+
+func informationSchema() *catalog.Schema {
+	s := &catalog.Schema{Name: "information_schema"}
+	s.Tables = []*catalog.Table{
+		informationSchemaTable("schemata", []column{
+			{name: "catalog_name", typ: "varchar", notNull: true},
+			{name: "schema_name", typ: "varchar", notNull: true},
+			{name: "default_character_set_name", typ: "varchar", notNull: true},
+			{name: "default_collation_name", typ: "varchar", notNull: true},
+			{name: "sql_path", typ: "varchar"},
+			{name: "default_encryption", typ: "varchar", notNull: true},
+		}),
+		informationSchemaTable("tables", []column{
+			{name: "table_catalog", typ: "varchar", notNull: true},
+			{name: "table_schema", typ: "varchar", notNull: true},
+			{name: "table_name", typ: "varchar", notNull: true},
+			{name: "table_type", typ: "varchar", notNull: true},
+			{name: "engine", typ: "varchar"},
+			{name: "version", typ: "int"},
+			{name: "row_format", typ: "varchar"},
+			{name: "table_rows", typ: "bigint", unsigned: true},
+			{name: "avg_row_length", typ: "bigint", unsigned: true},
+			{name: "data_length", typ: "bigint", unsigned: true},
+			{name: "max_data_length", typ: "bigint", unsigned: true},
+			{name: "index_length", typ: "bigint", unsigned: true},
+			{name: "data_free", typ: "bigint", unsigned: true},
+			{name: "auto_increment", typ: "bigint", unsigned: true},
+			{name: "create_time", typ: "timestamp", notNull: true},
+			{name: "update_time", typ: "datetime"},
+			{name: "check_time", typ: "datetime"},
+			{name: "table_collation", typ: "varchar"},
+			{name: "checksum", typ: "bigint"},
+			{name: "create_options", typ: "varchar"},
+			{name: "table_comment", typ: "text"},
+		}),
+		informationSchemaTable("columns", []column{
+			{name: "table_catalog", typ: "varchar", notNull: true},
+			{name: "table_schema", typ: "varchar", notNull: true},
+			{name: "table_name", typ: "varchar", notNull: true},
+			{name: "column_name", typ: "varchar", notNull: true},
+			{name: "ordinal_position", typ: "int", notNull: true, unsigned: true},
+			{name: "column_default", typ: "text"},
+			{name: "is_nullable", typ: "varchar", notNull: true},
+			{name: "data_type", typ: "longtext"},
+			{name: "character_maximum_length", typ: "bigint"},
+			{name: "character_octet_length", typ: "bigint"},
+			{name: "numeric_precision", typ: "bigint", unsigned: true},
+			{name: "numeric_scale", typ: "bigint", unsigned: true},
+			{name: "datetime_precision", typ: "int", unsigned: true},
+			{name: "character_set_name", typ: "varchar"},
+			{name: "collation_name", typ: "varchar"},
+			{name: "column_type", typ: "mediumtext", notNull: true},
+			{name: "column_key", typ: "varchar", notNull: true},
+			{name: "extra", typ: "varchar"},
+			{name: "privileges", typ: "varchar"},
+			{name: "column_comment", typ: "text", notNull: true},
+			{name: "generation_expression", typ: "longtext", notNull: true},
+			{name: "srs_id", typ: "int", unsigned: true},
+		}),
+		informationSchemaTable("views", []column{
+			{name: "table_catalog", typ: "varchar", notNull: true},
+			{name: "table_schema", typ: "varchar", notNull: true},
+			{name: "table_name", typ: "varchar", notNull: true},
+			{name: "view_definition", typ: "longtext"},
+			{name: "check_option", typ: "varchar"},
+			{name: "is_updatable", typ: "varchar"},
+			{name: "definer", typ: "varchar"},
+			{name: "security_type", typ: "varchar"},
+			{name: "character_set_client", typ: "varchar", notNull: true},
+			{name: "collation_connection", typ: "varchar", notNull: true},
+		}),
+		informationSchemaTable("statistics", []column{
+			{name: "table_catalog", typ: "varchar", notNull: true},
+			{name: "table_schema", typ: "varchar", notNull: true},
+			{name: "table_name", typ: "varchar", notNull: true},
+			{name: "non_unique", typ: "int", notNull: true},
+			{name: "index_schema", typ: "varchar", notNull: true},
+			{name: "index_name", typ: "varchar", notNull: true},
+			{name: "seq_in_index", typ: "int", notNull: true, unsigned: true},
+			{name: "column_name", typ: "varchar"},
+			{name: "collation", typ: "varchar"},
+			{name: "cardinality", typ: "bigint"},
+			{name: "sub_part", typ: "bigint"},
+			{name: "packed", typ: "varbinary"},
+			{name: "nullable", typ: "varchar", notNull: true},
+			{name: "index_type", typ: "varchar", notNull: true},
+			{name: "comment", typ: "varchar", notNull: true},
+			{name: "index_comment", typ: "varchar", notNull: true},
+			{name: "is_visible", typ: "varchar", notNull: true},
+			{name: "expression", typ: "longtext"},
+		}),
+		informationSchemaTable("table_constraints", []column{
+			{name: "constraint_catalog", typ: "varchar", notNull: true},
+			{name: "constraint_schema", typ: "varchar", notNull: true},
+			{name: "constraint_name", typ: "varchar"},
+			{name: "table_schema", typ: "varchar", notNull: true},
+			{name: "table_name", typ: "varchar", notNull: true},
+			{name: "constraint_type", typ: "varchar", notNull: true},
+			{name: "enforced", typ: "varchar", notNull: true},
+		}),
+		informationSchemaTable("key_column_usage", []column{
+			{name: "constraint_catalog", typ: "varchar", notNull: true},
+			{name: "constraint_schema", typ: "varchar", notNull: true},
+			{name: "constraint_name", typ: "varchar"},
+			{name: "table_catalog", typ: "varchar", notNull: true},
+			{name: "table_schema", typ: "varchar", notNull: true},
+			{name: "table_name", typ: "varchar", notNull: true},
+			{name: "column_name", typ: "varchar"},
+			{name: "ordinal_position", typ: "int", notNull: true, unsigned: true},
+			{name: "position_in_unique_constraint", typ: "int", unsigned: true},
+			{name: "referenced_table_schema", typ: "varchar"},
+			{name: "referenced_table_name", typ: "varchar"},
+			{name: "referenced_column_name", typ: "varchar"},
+		}),
+		informationSchemaTable("referential_constraints", []column{
+			{name: "constraint_catalog", typ: "varchar", notNull: true},
+			{name: "constraint_schema", typ: "varchar", notNull: true},
+			{name: "constraint_name", typ: "varchar"},
+			{name: "unique_constraint_catalog", typ: "varchar", notNull: true},
+			{name: "unique_constraint_schema", typ: "varchar", notNull: true},
+			{name: "unique_constraint_name", typ: "varchar"},
+			{name: "match_option", typ: "varchar", notNull: true},
+			{name: "update_rule", typ: "varchar", notNull: true},
+			{name: "delete_rule", typ: "varchar", notNull: true},
+			{name: "table_name", typ: "varchar", notNull: true},
+			{name: "referenced_table_name", typ: "varchar", notNull: true},
+		}),
+	}
+	return s
+}
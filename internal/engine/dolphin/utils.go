@@ -1,8 +1,12 @@
 package dolphin
 
 import (
+	"strings"
+
 	pcast "github.com/pingcap/tidb/parser/ast"
+	"github.com/pingcap/tidb/parser/format"
 	"github.com/pingcap/tidb/parser/mysql"
+	driver "github.com/pingcap/tidb/parser/test_driver"
 
 	"github.com/ZeyuRemtes/sqlc/internal/sql/ast"
 )
@@ -40,6 +44,30 @@ func isNotNull(n *pcast.ColumnDef) bool {
 	return false
 }
 
+func isPrimaryKey(n *pcast.ColumnDef) bool {
+	for i := range n.Options {
+		if n.Options[i].Tp == pcast.ColumnOptionPrimaryKey {
+			return true
+		}
+	}
+	return false
+}
+
+// defaultValue renders the default value of a column as SQL
+func defaultValue(expr pcast.ExprNode) string {
+	var b strings.Builder
+	ctx := format.NewRestoreCtx(format.DefaultRestoreFlags, &b)
+	// The test driver prefixes string values with their character set
+	if value, ok := expr.(*driver.ValueExpr); ok && value.Kind() == driver.KindString {
+		ctx.WriteString(value.GetString())
+		return b.String()
+	}
+	if err := expr.Restore(ctx); err != nil {
+		return ""
+	}
+	return b.String()
+}
+
 func convertToRangeVarList(list *ast.List, result *ast.List) {
 	if len(list.Items) == 0 {
 		return
@@ -4,10 +4,12 @@ import "github.com/ZeyuRemtes/sqlc/internal/sql/catalog"
 
 func NewCatalog() *catalog.Catalog {
 	def := "main"
+	s := defaultSchema(def)
+	addSystemObjects(s)
 	return &catalog.Catalog{
 		DefaultSchema: def,
 		Schemas: []*catalog.Schema{
-			defaultSchema(def),
+			s,
 		},
 		Extensions: map[string]struct{}{},
 	}
@@ -38,6 +38,84 @@ func TestUpdate(t *testing.T) {
 				},
 			},
 		},
+		{
+			`
+			CREATE TABLE foo (bar text, baz text);
+			CREATE INDEX foo_bar ON foo (bar);
+			CREATE UNIQUE INDEX foo_baz ON foo (baz, bar);
+			DROP INDEX foo_bar;
+			`,
+			&catalog.Schema{
+				Name: "main",
+				Tables: []*catalog.Table{
+					{
+						Rel: &ast.TableName{Name: "foo"},
+						Columns: []*catalog.Column{
+							{
+								Name: "bar",
+								Type: ast.TypeName{Name: "text"},
+							},
+							{
+								Name: "baz",
+								Type: ast.TypeName{Name: "text"},
+							},
+						},
+						Indexes: []*catalog.Index{
+							{
+								Name:    "foo_baz",
+								Columns: []string{"baz", "bar"},
+								Unique:  true,
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			`
+			CREATE TABLE foo (id integer PRIMARY KEY, bar text);
+			CREATE TABLE baz (a text, b text, c text, PRIMARY KEY (a, b));
+			`,
+			&catalog.Schema{
+				Name: "main",
+				Tables: []*catalog.Table{
+					{
+						Rel: &ast.TableName{Name: "foo"},
+						Columns: []*catalog.Column{
+							{
+								Name:         "id",
+								Type:         ast.TypeName{Name: "integer"},
+								IsNotNull:    true,
+								IsPrimaryKey: true,
+							},
+							{
+								Name: "bar",
+								Type: ast.TypeName{Name: "text"},
+							},
+						},
+					},
+					{
+						Rel: &ast.TableName{Name: "baz"},
+						Columns: []*catalog.Column{
+							{
+								Name:         "a",
+								Type:         ast.TypeName{Name: "text"},
+								IsPrimaryKey: true,
+							},
+							{
+								Name:         "b",
+								Type:         ast.TypeName{Name: "text"},
+								IsPrimaryKey: true,
+							},
+							{
+								Name: "c",
+								Type: ast.TypeName{Name: "text"},
+							},
+						},
+					},
+				},
+			},
+		},
 		{
 			`
 			CREATE TABLE foo (bar text);
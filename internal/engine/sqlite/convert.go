@@ -73,6 +73,7 @@ func (c *cc) convertAlter_table_stmtContext(n *parser.Alter_table_stmtContext) a
 						Name: def.Type_name().GetText(),
 					},
 					IsNotNull: hasNotNullConstraint(def.AllColumn_constraint()),
+					Default:   columnDefault(def.AllColumn_constraint()),
 				},
 			})
 			return stmt
@@ -114,12 +115,57 @@ func (c *cc) convertCreate_table_stmtContext(n *parser.Create_table_stmtContext)
 				typeName = def.Type_name().GetText()
 			}
 			stmt.Cols = append(stmt.Cols, &ast.ColumnDef{
-				Colname:   identifier(def.Column_name().GetText()),
-				IsNotNull: hasNotNullConstraint(def.AllColumn_constraint()),
-				TypeName:  &ast.TypeName{Name: typeName},
+				Colname:         identifier(def.Column_name().GetText()),
+				IsNotNull:       hasNotNullConstraint(def.AllColumn_constraint()),
+				IsPrimaryKey:    hasPrimaryKeyConstraint(def.AllColumn_constraint()),
+				IsAutoIncrement: hasAutoIncrement(def.AllColumn_constraint()),
+				Default:         columnDefault(def.AllColumn_constraint()),
+				TypeName:        &ast.TypeName{Name: typeName},
 			})
 		}
 	}
+	for _, icon := range n.AllTable_constraint() {
+		con, ok := icon.(*parser.Table_constraintContext)
+		if !ok || con.PRIMARY_() == nil {
+			continue
+		}
+		for _, icol := range con.AllIndexed_column() {
+			name := identifier(icol.(*parser.Indexed_columnContext).Column_name().GetText())
+			for _, col := range stmt.Cols {
+				if col.Colname == name {
+					col.IsPrimaryKey = true
+				}
+			}
+		}
+	}
+	return stmt
+}
+
+func (c *cc) convertCreate_index_stmtContext(n *parser.Create_index_stmtContext) ast.Node {
+	name := parseTableName(n)
+	idxname := n.Index_name().GetText()
+	stmt := &ast.IndexStmt{
+		Idxname: &idxname,
+		Relation: &ast.RangeVar{
+			Relname: &name.Name,
+		},
+		IndexParams: &ast.List{},
+		Unique:      n.UNIQUE_() != nil,
+		IfNotExists: n.EXISTS_() != nil,
+	}
+	if name.Schema != "" {
+		stmt.Relation.Schemaname = &name.Schema
+	}
+	for _, icol := range n.AllIndexed_column() {
+		col, ok := icol.(*parser.Indexed_columnContext)
+		if !ok || col.Column_name() == nil {
+			continue
+		}
+		colname := identifier(col.Column_name().GetText())
+		stmt.IndexParams.Items = append(stmt.IndexParams.Items, &ast.IndexElem{
+			Name: &colname,
+		})
+	}
 	return stmt
 }
 
@@ -217,6 +263,16 @@ func (c *cc) convertDrop_stmtContext(n *parser.Drop_stmtContext) ast.Node {
 			Tables:   []*ast.TableName{&name},
 		}
 	}
+	if n.INDEX_() != nil {
+		stmt := &ast.DropIndexStmt{
+			IfExists: n.EXISTS_() != nil,
+			Name:     n.Any_name().GetText(),
+		}
+		if n.Schema_name() != nil {
+			stmt.Schema = n.Schema_name().GetText()
+		}
+		return stmt
+	}
 	return todo("convertDrop_stmtContext", n)
 }
 
@@ -609,8 +665,15 @@ func (c *cc) convertMathOperationNode(n *parser.Expr_math_opContext) ast.Node {
 }
 
 func (c *cc) convertBinaryNode(n *parser.Expr_binaryContext) ast.Node {
+	var op ast.BoolExprType
+	switch {
+	case n.AND_() != nil:
+		op = ast.BoolExprTypeAnd
+	case n.OR_() != nil:
+		op = ast.BoolExprTypeOr
+	}
 	return &ast.BoolExpr{
-		// TODO: Set op
+		Boolop: op,
 		Args: &ast.List{
 			Items: []ast.Node{
 				c.convert(n.Expr(0)),
@@ -667,6 +730,7 @@ func (c *cc) convertReturning_caluseContext(n parser.IReturning_clauseContext) *
 		list.Items = append(list.Items, &ast.ResTarget{
 			Indirection: &ast.List{},
 			Val:         c.convert(exp),
+			Location:    exp.GetStart().GetStart(),
 		})
 	}
 
@@ -754,7 +818,14 @@ func (c *cc) convertTablesOrSubquery(n []parser.ITable_or_subqueryContext) []ast
 			continue
 		}
 
-		if from.Table_name() != nil {
+		if from.Table_name() != nil && isFunctionArgs(from) {
+			// The grammar accepts a parenthesized string as a table alias, so
+			// pragma_table_info('t') is parsed as a table named pragma_table_info
+			// aliased as ('t'). Treat it as a table-valued function call instead.
+			rel := from.Table_name().GetText()
+			arg := from.Table_alias().Any_name().Any_name()
+			tables = append(tables, newRangeFunction(rel, []ast.Node{convertAnyName(arg)}, from.GetStart().GetStart()))
+		} else if from.Table_name() != nil {
 			rel := from.Table_name().GetText()
 			rv := &ast.RangeVar{
 				Relname:  &rel,
@@ -765,7 +836,7 @@ func (c *cc) convertTablesOrSubquery(n []parser.ITable_or_subqueryContext) []ast
 				schema := from.Schema_name().GetText()
 				rv.Schemaname = &schema
 			}
-			if from.Table_alias() != nil {
+			if from.Table_alias() != nil && !isJoinKeyword(from) {
 				alias := from.Table_alias().GetText()
 				rv.Alias = &ast.Alias{Aliasname: &alias}
 			}
@@ -773,26 +844,11 @@ func (c *cc) convertTablesOrSubquery(n []parser.ITable_or_subqueryContext) []ast
 			tables = append(tables, rv)
 		} else if from.Table_function_name() != nil {
 			rel := from.Table_function_name().GetText()
-			rf := &ast.RangeFunction{
-				Functions: &ast.List{
-					Items: []ast.Node{
-						&ast.FuncCall{
-							Func: &ast.FuncName{
-								Name: rel,
-							},
-							Funcname: &ast.List{
-								Items: []ast.Node{
-									NewIdentifer(rel),
-								},
-							},
-							Args: &ast.List{
-								Items: []ast.Node{&ast.TODO{}},
-							},
-							Location: from.GetStart().GetStart(),
-						},
-					},
-				},
+			var args []ast.Node
+			for _, expr := range from.AllExpr() {
+				args = append(args, c.convert(expr))
 			}
+			rf := newRangeFunction(rel, args, from.GetStart().GetStart())
 
 			if from.Table_alias() != nil {
 				alias := from.Table_alias().GetText()
@@ -817,6 +873,75 @@ func (c *cc) convertTablesOrSubquery(n []parser.ITable_or_subqueryContext) []ast
 	return tables
 }
 
+// The grammar accepts keywords as table aliases, so the LEFT in
+// "a LEFT JOIN b" is parsed as an alias of a. It's part of the join instead.
+func isJoinKeyword(from *parser.Table_or_subqueryContext) bool {
+	if from.AS_() != nil || from.Table_alias() == nil {
+		return false
+	}
+	name, ok := from.Table_alias().Any_name().(*parser.Any_nameContext)
+	if !ok || name.Keyword() == nil {
+		return false
+	}
+	switch strings.ToUpper(name.GetText()) {
+	case "LEFT", "NATURAL", "INNER", "CROSS":
+		return true
+	}
+	return false
+}
+
+func isFunctionArgs(from *parser.Table_or_subqueryContext) bool {
+	if from.AS_() != nil || from.Table_alias() == nil {
+		return false
+	}
+	name, ok := from.Table_alias().Any_name().(*parser.Any_nameContext)
+	return ok && name.OPEN_PAR() != nil
+}
+
+func convertAnyName(n parser.IAny_nameContext) ast.Node {
+	name, ok := n.(*parser.Any_nameContext)
+	if !ok {
+		return todo("convertAnyName", n)
+	}
+	if name.STRING_LITERAL() != nil {
+		// remove surrounding single quote
+		text := name.GetText()
+		return &ast.A_Const{
+			Val:      &ast.String{Str: text[1 : len(text)-1]},
+			Location: name.GetStart().GetStart(),
+		}
+	}
+	return &ast.ColumnRef{
+		Fields: &ast.List{
+			Items: []ast.Node{NewIdentifer(name.GetText())},
+		},
+		Location: name.GetStart().GetStart(),
+	}
+}
+
+func newRangeFunction(name string, args []ast.Node, loc int) *ast.RangeFunction {
+	return &ast.RangeFunction{
+		Functions: &ast.List{
+			Items: []ast.Node{
+				&ast.FuncCall{
+					Func: &ast.FuncName{
+						Name: name,
+					},
+					Funcname: &ast.List{
+						Items: []ast.Node{
+							NewIdentifer(name),
+						},
+					},
+					Args: &ast.List{
+						Items: args,
+					},
+					Location: loc,
+				},
+			},
+		},
+	}
+}
+
 type Update_stmt interface {
 	Qualified_table_name() parser.IQualified_table_nameContext
 	GetStart() antlr.Token
@@ -896,6 +1021,9 @@ func (c *cc) convert(node node) ast.Node {
 	case *parser.Attach_stmtContext:
 		return c.convertAttach_stmtContext(n)
 
+	case *parser.Create_index_stmtContext:
+		return c.convertCreate_index_stmtContext(n)
+
 	case *parser.Create_table_stmtContext:
 		return c.convertCreate_table_stmtContext(n)
 
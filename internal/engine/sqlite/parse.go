@@ -1,7 +1,6 @@
 package sqlite
 
 import (
-	"errors"
 	"fmt"
 	"io"
 
@@ -10,16 +9,24 @@ import (
 	"github.com/ZeyuRemtes/sqlc/internal/engine/sqlite/parser"
 	"github.com/ZeyuRemtes/sqlc/internal/metadata"
 	"github.com/ZeyuRemtes/sqlc/internal/sql/ast"
+	"github.com/ZeyuRemtes/sqlc/internal/sql/sqlerr"
 )
 
 type errorListener struct {
 	*antlr.DefaultErrorListener
 
-	err string
+	err    string
+	line   int
+	column int
 }
 
 func (el *errorListener) SyntaxError(recognizer antlr.Recognizer, offendingSymbol interface{}, line, column int, msg string, e antlr.RecognitionException) {
+	if el.err != "" {
+		return
+	}
 	el.err = msg
+	el.line = line
+	el.column = column + 1 // ANTLR columns are zero-based
 }
 
 // func (el *errorListener) ReportAmbiguity(recognizer antlr.Parser, dfa *antlr.DFA, startIndex, stopIndex int, exact bool, ambigAlts *antlr.BitSet, configs antlr.ATNConfigSet) {
@@ -52,7 +59,11 @@ func (p *Parser) Parse(r io.Reader) ([]ast.Statement, error) {
 	// pp.BuildParseTrees = true
 	tree := pp.Parse()
 	if el.err != "" {
-		return nil, errors.New(el.err)
+		return nil, &sqlerr.Error{
+			Message: el.err,
+			Line:    el.line,
+			Column:  el.column,
+		}
 	}
 	pctx, ok := tree.(*parser.ParseContext)
 	if !ok {
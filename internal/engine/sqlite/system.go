@@ -0,0 +1,144 @@
+package sqlite
+
+import (
+	"github.com/ZeyuRemtes/sqlc/internal/sql/ast"
+	"github.com/ZeyuRemtes/sqlc/internal/sql/catalog"
+)
+
+// Built-in tables and table-valued pragma functions from:
+// 		 https://www.sqlite.org/schematab.html
+// 		 https://www.sqlite.org/pragma.html#pragfunc
+//
+// Names starting with "sqlite_" are reserved by SQLite, so they can't collide
+// with user tables. The result of each pragma function is described by a
+// table of the same name with that prefix.
+
+type column struct {
+	name    string
+	typ     string
+	notNull bool
+}
+
+func systemTable(name string, cols []column) *catalog.Table {
+	t := &catalog.Table{
+		Rel:      &ast.TableName{Name: name},
+		IsSystem: true,
+	}
+	for _, c := range cols {
+		t.Columns = append(t.Columns, &catalog.Column{
+			Name:      c.name,
+			Type:      ast.TypeName{Name: c.typ},
+			IsNotNull: c.notNull,
+		})
+	}
+	return t
+}
+
+func schemaTable(name string) *catalog.Table {
+	return systemTable(name, []column{
+		{name: "type", typ: "text", notNull: true},
+		{name: "name", typ: "text", notNull: true},
+		{name: "tbl_name", typ: "text", notNull: true},
+		{name: "rootpage", typ: "integer", notNull: true},
+		{name: "sql", typ: "text"},
+	})
+}
+
+var pragmas = map[string][]column{
+	"pragma_table_info": {
+		{name: "cid", typ: "integer", notNull: true},
+		{name: "name", typ: "text", notNull: true},
+		{name: "type", typ: "text", notNull: true},
+		{name: "notnull", typ: "integer", notNull: true},
+		{name: "dflt_value", typ: "text"},
+		{name: "pk", typ: "integer", notNull: true},
+	},
+	"pragma_table_xinfo": {
+		{name: "cid", typ: "integer", notNull: true},
+		{name: "name", typ: "text", notNull: true},
+		{name: "type", typ: "text", notNull: true},
+		{name: "notnull", typ: "integer", notNull: true},
+		{name: "dflt_value", typ: "text"},
+		{name: "pk", typ: "integer", notNull: true},
+		{name: "hidden", typ: "integer", notNull: true},
+	},
+	"pragma_table_list": {
+		{name: "schema", typ: "text", notNull: true},
+		{name: "name", typ: "text", notNull: true},
+		{name: "type", typ: "text", notNull: true},
+		{name: "ncol", typ: "integer", notNull: true},
+		{name: "wr", typ: "integer", notNull: true},
+		{name: "strict", typ: "integer", notNull: true},
+	},
+	"pragma_index_list": {
+		{name: "seq", typ: "integer", notNull: true},
+		{name: "name", typ: "text", notNull: true},
+		{name: "unique", typ: "integer", notNull: true},
+		{name: "origin", typ: "text", notNull: true},
+		{name: "partial", typ: "integer", notNull: true},
+	},
+	"pragma_index_info": {
+		{name: "seqno", typ: "integer", notNull: true},
+		{name: "cid", typ: "integer", notNull: true},
+		{name: "name", typ: "text"},
+	},
+	"pragma_index_xinfo": {
+		{name: "seqno", typ: "integer", notNull: true},
+		{name: "cid", typ: "integer", notNull: true},
+		{name: "name", typ: "text"},
+		{name: "desc", typ: "integer", notNull: true},
+		{name: "coll", typ: "text", notNull: true},
+		{name: "key", typ: "integer", notNull: true},
+	},
+	"pragma_foreign_key_list": {
+		{name: "id", typ: "integer", notNull: true},
+		{name: "seq", typ: "integer", notNull: true},
+		{name: "table", typ: "text", notNull: true},
+		{name: "from", typ: "text", notNull: true},
+		{name: "to", typ: "text"},
+		{name: "on_update", typ: "text", notNull: true},
+		{name: "on_delete", typ: "text", notNull: true},
+		{name: "match", typ: "text", notNull: true},
+	},
+	"pragma_database_list": {
+		{name: "seq", typ: "integer", notNull: true},
+		{name: "name", typ: "text", notNull: true},
+		{name: "file", typ: "text", notNull: true},
+	},
+}
+
+func addSystemObjects(s *catalog.Schema) {
+	s.Tables = append(s.Tables,
+		schemaTable("sqlite_schema"),
+		schemaTable("sqlite_master"),
+		schemaTable("sqlite_temp_schema"),
+		schemaTable("sqlite_temp_master"),
+		systemTable("sqlite_sequence", []column{
+			{name: "name", typ: "text"},
+			{name: "seq", typ: "integer"},
+		}),
+	)
+	for _, name := range []string{
+		"pragma_table_info",
+		"pragma_table_xinfo",
+		"pragma_table_list",
+		"pragma_index_list",
+		"pragma_index_info",
+		"pragma_index_xinfo",
+		"pragma_foreign_key_list",
+		"pragma_database_list",
+	} {
+		result := "sqlite_" + name
+		s.Tables = append(s.Tables, systemTable(result, pragmas[name]))
+		s.Funcs = append(s.Funcs, &catalog.Function{
+			Name: name,
+			Args: []*catalog.Argument{
+				{
+					Type:       &ast.TypeName{Name: "text"},
+					HasDefault: true,
+				},
+			},
+			ReturnType: &ast.TypeName{Name: result},
+		})
+	}
+}
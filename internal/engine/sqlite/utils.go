@@ -1,6 +1,8 @@
 package sqlite
 
 import (
+	"github.com/antlr/antlr4/runtime/Go/antlr/v4"
+
 	"github.com/ZeyuRemtes/sqlc/internal/engine/sqlite/parser"
 	"github.com/ZeyuRemtes/sqlc/internal/sql/ast"
 )
@@ -20,6 +22,53 @@ func parseTableName(c tableNamer) *ast.TableName {
 	return &name
 }
 
+func hasPrimaryKeyConstraint(checks []parser.IColumn_constraintContext) bool {
+	for i := range checks {
+		constraint, ok := checks[i].(*parser.Column_constraintContext)
+		if !ok {
+			continue
+		}
+		if constraint.PRIMARY_() != nil && constraint.KEY_() != nil {
+			return true
+		}
+	}
+	return false
+}
+
+func hasAutoIncrement(checks []parser.IColumn_constraintContext) bool {
+	for i := range checks {
+		constraint, ok := checks[i].(*parser.Column_constraintContext)
+		if ok && constraint.AUTOINCREMENT_() != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// columnDefault returns the default value of a column as written in the
+// schema, or an empty string if it has none
+func columnDefault(checks []parser.IColumn_constraintContext) string {
+	for i := range checks {
+		constraint, ok := checks[i].(*parser.Column_constraintContext)
+		if !ok || constraint.DEFAULT_() == nil {
+			continue
+		}
+		var start, stop antlr.Token
+		switch {
+		case constraint.Signed_number() != nil:
+			start, stop = constraint.Signed_number().GetStart(), constraint.Signed_number().GetStop()
+		case constraint.Literal_value() != nil:
+			start, stop = constraint.Literal_value().GetStart(), constraint.Literal_value().GetStop()
+		case constraint.OPEN_PAR() != nil && constraint.CLOSE_PAR() != nil:
+			start, stop = constraint.OPEN_PAR().GetSymbol(), constraint.CLOSE_PAR().GetSymbol()
+		default:
+			continue
+		}
+		return start.GetInputStream().GetTextFromInterval(antlr.NewInterval(start.GetStart(), stop.GetStop()))
+	}
+	return ""
+}
+
 func hasNotNullConstraint(checks []parser.IColumn_constraintContext) bool {
 	for i := range checks {
 		constraint, ok := checks[i].(*parser.Column_constraintContext)
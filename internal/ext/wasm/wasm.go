@@ -21,6 +21,7 @@ import (
 	wasmtime "github.com/bytecodealliance/wasmtime-go/v8"
 	"golang.org/x/sync/singleflight"
 
+	sqlccache "github.com/ZeyuRemtes/sqlc/internal/cache"
 	"github.com/ZeyuRemtes/sqlc/internal/info"
 	"github.com/ZeyuRemtes/sqlc/internal/plugin"
 )
@@ -28,22 +29,6 @@ import (
 // This version must be updated whenever the wasmtime-go dependency is updated
 const wasmtimeVersion = `v8.0.0`
 
-func cacheDir() (string, error) {
-	cache := os.Getenv("SQLCCACHE")
-	if cache != "" {
-		return cache, nil
-	}
-	cacheHome := os.Getenv("XDG_CACHE_HOME")
-	if cacheHome == "" {
-		home, err := os.UserHomeDir()
-		if err != nil {
-			return "", err
-		}
-		cacheHome = filepath.Join(home, ".cache")
-	}
-	return filepath.Join(cacheHome, "sqlc"), nil
-}
-
 type Runner struct {
 	URL    string
 	SHA256 string
@@ -82,14 +67,10 @@ func (r *Runner) loadSerializedModule(ctx context.Context, engine *wasmtime.Engi
 	if err != nil {
 		return nil, err
 	}
-	cacheRoot, err := cacheDir()
+	cache, err := sqlccache.PluginsDir()
 	if err != nil {
 		return nil, err
 	}
-	cache := filepath.Join(cacheRoot, "plugins")
-	if err := os.MkdirAll(cache, 0755); err != nil && !os.IsExist(err) {
-		return nil, fmt.Errorf("failed to create cache directory: %w", err)
-	}
 
 	pluginDir := filepath.Join(cache, expected)
 	modName := fmt.Sprintf("plugin_%s_%s_%s.module", runtime.GOOS, runtime.GOARCH, wasmtimeVersion)
@@ -0,0 +1,68 @@
+package introspect
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ZeyuRemtes/sqlc/internal/config"
+)
+
+// DDL renders tables as a schema file that can be checked in and used in
+// place of the database. Statements the database kept verbatim are copied
+// as-is; the rest are rebuilt from their columns.
+func DDL(engine config.Engine, tables []*Table) string {
+	var b strings.Builder
+	for i, t := range tables {
+		if i > 0 {
+			b.WriteString("\n")
+		}
+		if t.SQL != "" {
+			b.WriteString(strings.TrimRight(t.SQL, "; \t\n"))
+			b.WriteString(";\n")
+			continue
+		}
+		fmt.Fprintf(&b, "CREATE TABLE %s (\n", quote(engine, t.Name))
+		var keys []string
+		for _, c := range t.Columns {
+			if c.PrimaryKey {
+				keys = append(keys, quote(engine, c.Name))
+			}
+		}
+		for j, c := range t.Columns {
+			fmt.Fprintf(&b, "  %s", quote(engine, c.Name))
+			if c.DeclaredType != "" {
+				fmt.Fprintf(&b, " %s", c.DeclaredType)
+			}
+			if c.NotNull {
+				b.WriteString(" NOT NULL")
+			}
+			if c.Comment != "" {
+				fmt.Fprintf(&b, " COMMENT %s", literal(c.Comment))
+			}
+			if j < len(t.Columns)-1 || len(keys) > 0 {
+				b.WriteString(",")
+			}
+			b.WriteString("\n")
+		}
+		if len(keys) > 0 {
+			fmt.Fprintf(&b, "  PRIMARY KEY (%s)\n", strings.Join(keys, ", "))
+		}
+		b.WriteString(")")
+		if t.Comment != "" {
+			fmt.Fprintf(&b, " COMMENT=%s", literal(t.Comment))
+		}
+		b.WriteString(";\n")
+	}
+	return b.String()
+}
+
+func quote(engine config.Engine, name string) string {
+	if engine == config.EngineMySQL {
+		return "`" + strings.ReplaceAll(name, "`", "``") + "`"
+	}
+	return `"` + strings.ReplaceAll(name, `"`, `""`) + `"`
+}
+
+func literal(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", "''") + "'"
+}
@@ -0,0 +1,124 @@
+// Package introspect reads table definitions from a live database, so that a
+// catalog can be built without any DDL files.
+package introspect
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	_ "github.com/go-sql-driver/mysql"
+	_ "github.com/mattn/go-sqlite3"
+
+	"github.com/ZeyuRemtes/sqlc/internal/config"
+	"github.com/ZeyuRemtes/sqlc/internal/sql/ast"
+)
+
+// Table is a table or view read from a database.
+type Table struct {
+	Name    string
+	View    bool
+	Comment string
+	Columns []*Column
+
+	// The statement that created the table, if the database keeps it
+	SQL string
+}
+
+// Column is a column of a table or view. Name and Type are normalized the
+// same way the engine's parser normalizes them.
+type Column struct {
+	Name     string
+	Type     string
+	NotNull  bool
+	Unsigned bool
+	Length   *int
+	Vals     []string
+	Comment  string
+
+	PrimaryKey bool
+
+	// The type as it would be written in a CREATE TABLE statement
+	DeclaredType string
+}
+
+// Load reads every table and view visible through the connection described
+// by url. For SQLite, url is the path to the database file, which is opened
+// read-only.
+func Load(ctx context.Context, engine config.Engine, url string) ([]*Table, error) {
+	switch engine {
+	case config.EngineSQLite:
+		db, err := sql.Open("sqlite3", readOnly(url))
+		if err != nil {
+			return nil, fmt.Errorf("database: connection error: %s", err)
+		}
+		defer db.Close()
+		if err := db.PingContext(ctx); err != nil {
+			return nil, fmt.Errorf("database: connection error: %s", err)
+		}
+		return loadSQLite(ctx, db)
+	case config.EngineMySQL:
+		db, err := sql.Open("mysql", url)
+		if err != nil {
+			return nil, fmt.Errorf("database: connection error: %s", err)
+		}
+		defer db.Close()
+		if err := db.PingContext(ctx); err != nil {
+			return nil, fmt.Errorf("database: connection error: %s", err)
+		}
+		return loadMySQL(ctx, db)
+	default:
+		return nil, fmt.Errorf("database: introspection is not supported for engine %s", engine)
+	}
+}
+
+// readOnly makes sure a missing SQLite file results in an error instead of a
+// new, empty database.
+func readOnly(url string) string {
+	if !strings.HasPrefix(url, "file:") {
+		url = "file:" + url
+	}
+	if strings.Contains(url, "mode=") {
+		return url
+	}
+	if strings.Contains(url, "?") {
+		return url + "&mode=ro"
+	}
+	return url + "?mode=ro"
+}
+
+// Statements returns a CREATE TABLE statement for each table, which can be
+// applied to a catalog like any statement parsed from a schema file. Views
+// become tables, as their columns are all a query can see of them.
+func Statements(tables []*Table) []ast.Statement {
+	var stmts []ast.Statement
+	for _, t := range tables {
+		stmt := &ast.CreateTableStmt{
+			Name:    &ast.TableName{Name: strings.ToLower(t.Name)},
+			Comment: t.Comment,
+		}
+		for _, c := range t.Columns {
+			def := &ast.ColumnDef{
+				Colname:      c.Name,
+				TypeName:     &ast.TypeName{Name: c.Type},
+				IsNotNull:    c.NotNull,
+				IsUnsigned:   c.Unsigned,
+				IsPrimaryKey: c.PrimaryKey,
+				Length:       c.Length,
+				Comment:      c.Comment,
+			}
+			if len(c.Vals) > 0 {
+				def.Vals = &ast.List{}
+				for _, v := range c.Vals {
+					def.Vals.Items = append(def.Vals.Items, &ast.String{Str: v})
+				}
+			}
+			stmt.Cols = append(stmt.Cols, def)
+		}
+		stmts = append(stmts, ast.Statement{
+			Raw: &ast.RawStmt{Stmt: stmt},
+		})
+	}
+	return stmts
+}
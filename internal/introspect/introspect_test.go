@@ -0,0 +1,122 @@
+package introspect
+
+import (
+	"context"
+	"database/sql"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/ZeyuRemtes/sqlc/internal/config"
+)
+
+func TestLoadSQLite(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "app.db")
+	db, err := sql.Open("sqlite3", path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = db.Exec(`
+		CREATE TABLE Authors (id INTEGER PRIMARY KEY, Name TEXT NOT NULL, bio, score DOUBLE  PRECISION);
+		CREATE VIEW author_names AS SELECT name FROM authors;
+		CREATE TABLE counters (id INTEGER PRIMARY KEY AUTOINCREMENT);
+		CREATE TABLE sqlitex (id INTEGER PRIMARY KEY);`)
+	db.Close()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tables, err := Load(context.Background(), config.EngineSQLite, path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []*Table{
+		{
+			Name: "Authors",
+			SQL:  "CREATE TABLE Authors (id INTEGER PRIMARY KEY, Name TEXT NOT NULL, bio, score DOUBLE  PRECISION)",
+			Columns: []*Column{
+				{Name: "id", Type: "INTEGER", NotNull: true, PrimaryKey: true, DeclaredType: "INTEGER"},
+				{Name: "name", Type: "TEXT", NotNull: true, DeclaredType: "TEXT"},
+				{Name: "bio", Type: "any"},
+				{Name: "score", Type: "DOUBLEPRECISION", DeclaredType: "DOUBLE  PRECISION"},
+			},
+		},
+		{
+			Name:    "author_names",
+			View:    true,
+			SQL:     "CREATE VIEW author_names AS SELECT name FROM authors",
+			Columns: []*Column{{Name: "name", Type: "TEXT", DeclaredType: "TEXT"}},
+		},
+		{
+			Name:    "counters",
+			SQL:     "CREATE TABLE counters (id INTEGER PRIMARY KEY AUTOINCREMENT)",
+			Columns: []*Column{{Name: "id", Type: "INTEGER", NotNull: true, PrimaryKey: true, DeclaredType: "INTEGER"}},
+		},
+		{
+			Name:    "sqlitex",
+			SQL:     "CREATE TABLE sqlitex (id INTEGER PRIMARY KEY)",
+			Columns: []*Column{{Name: "id", Type: "INTEGER", NotNull: true, PrimaryKey: true, DeclaredType: "INTEGER"}},
+		},
+	}
+	if diff := cmp.Diff(want, tables); diff != "" {
+		t.Errorf("tables differ:\n%s", diff)
+	}
+
+	ddl := DDL(config.EngineSQLite, tables[1:2])
+	if ddl != "CREATE VIEW author_names AS SELECT name FROM authors;\n" {
+		t.Errorf("unexpected DDL: %q", ddl)
+	}
+
+	if _, err := Load(context.Background(), config.EngineSQLite, filepath.Join(t.TempDir(), "missing.db")); err == nil {
+		t.Errorf("expected an error for a missing database file")
+	}
+}
+
+func TestMySQLColumn(t *testing.T) {
+	length := func(n int) *int { return &n }
+	for _, test := range []struct {
+		dataType   string
+		columnType string
+		want       *Column
+	}{
+		{"varchar", "varchar(255)", &Column{Type: "varchar", Length: length(255)}},
+		{"int", "int unsigned", &Column{Type: "int", Unsigned: true}},
+		{"tinyint", "tinyint(1)", &Column{Type: "tinyint", Length: length(1)}},
+		{"decimal", "decimal(10,2)", &Column{Type: "decimal", Length: length(10)}},
+		{"enum", "enum('a','it''s','b,c')", &Column{Type: "enum", Vals: []string{"a", "it's", "b,c"}}},
+	} {
+		got := mysqlColumn("", test.dataType, test.columnType, false, "")
+		test.want.DeclaredType = test.columnType
+		if diff := cmp.Diff(test.want, got); diff != "" {
+			t.Errorf("%s:\n%s", test.columnType, diff)
+		}
+	}
+}
+
+func TestMySQLColumnName(t *testing.T) {
+	if col := mysqlColumn("AuthorID", "int", "int", true, ""); col.Name != "authorid" {
+		t.Errorf("expected the column name to be lowercased; got %q", col.Name)
+	}
+}
+
+func TestDDL(t *testing.T) {
+	tables := []*Table{
+		{
+			Name:    "authors",
+			Comment: "people who write",
+			Columns: []*Column{
+				{Name: "id", DeclaredType: "bigint unsigned", NotNull: true, PrimaryKey: true},
+				{Name: "bio", DeclaredType: "text", Comment: "it's optional"},
+			},
+		},
+	}
+	want := "CREATE TABLE `authors` (\n" +
+		"  `id` bigint unsigned NOT NULL,\n" +
+		"  `bio` text COMMENT 'it''s optional',\n" +
+		"  PRIMARY KEY (`id`)\n" +
+		") COMMENT='people who write';\n"
+	if got := DDL(config.EngineMySQL, tables); got != want {
+		t.Errorf("unexpected DDL:\n%s", got)
+	}
+}
@@ -0,0 +1,165 @@
+package introspect
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+func loadMySQL(ctx context.Context, db *sql.DB) ([]*Table, error) {
+	rows, err := db.QueryContext(ctx, `
+		SELECT table_name, table_type, coalesce(table_comment, '')
+		FROM information_schema.tables
+		WHERE table_schema = DATABASE()
+		ORDER BY table_name`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var tables []*Table
+	byName := map[string]*Table{}
+	for rows.Next() {
+		var typ string
+		t := &Table{}
+		if err := rows.Scan(&t.Name, &typ, &t.Comment); err != nil {
+			return nil, err
+		}
+		t.View = typ == "VIEW"
+		if t.View {
+			// Views report "VIEW" as their comment
+			t.Comment = ""
+		}
+		tables = append(tables, t)
+		byName[t.Name] = t
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	rows, err = db.QueryContext(ctx, `
+		SELECT table_name, column_name, data_type, column_type, is_nullable, column_key, column_comment
+		FROM information_schema.columns
+		WHERE table_schema = DATABASE()
+		ORDER BY table_name, ordinal_position`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var table, name, dataType, columnType, nullable, key, comment string
+		if err := rows.Scan(&table, &name, &dataType, &columnType, &nullable, &key, &comment); err != nil {
+			return nil, err
+		}
+		t, ok := byName[table]
+		if !ok {
+			continue
+		}
+		col := mysqlColumn(name, dataType, columnType, nullable == "NO", comment)
+		col.PrimaryKey = key == "PRI"
+		t.Columns = append(t.Columns, col)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	rows, err = db.QueryContext(ctx, `
+		SELECT table_name, view_definition
+		FROM information_schema.views
+		WHERE table_schema = DATABASE()`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var name, def string
+		if err := rows.Scan(&name, &def); err != nil {
+			return nil, err
+		}
+		if t, ok := byName[name]; ok {
+			t.SQL = fmt.Sprintf("CREATE VIEW `%s` AS %s", name, def)
+		}
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	return tables, rows.Err()
+}
+
+func mysqlColumn(name, dataType, columnType string, notNull bool, comment string) *Column {
+	col := &Column{
+		// Column names are case-insensitive, and lowercased by the parser
+		Name:         strings.ToLower(name),
+		Type:         strings.ToLower(dataType),
+		NotNull:      notNull,
+		Unsigned:     strings.Contains(strings.ToLower(columnType), "unsigned"),
+		Comment:      comment,
+		DeclaredType: columnType,
+	}
+	switch col.Type {
+	case "enum", "set":
+		col.Vals = mysqlValues(columnType)
+	default:
+		col.Length = mysqlLength(columnType)
+	}
+	return col
+}
+
+// mysqlLength returns the length or display width in a column type such as
+// varchar(255) or tinyint(1)
+func mysqlLength(columnType string) *int {
+	open := strings.IndexByte(columnType, '(')
+	if open < 0 {
+		return nil
+	}
+	end := strings.IndexAny(columnType[open:], ",)")
+	if end < 0 {
+		return nil
+	}
+	length, err := strconv.Atoi(columnType[open+1 : open+end])
+	if err != nil {
+		return nil
+	}
+	return &length
+}
+
+// mysqlValues returns the values in a column type such as enum('a','b')
+func mysqlValues(columnType string) []string {
+	open := strings.IndexByte(columnType, '(')
+	if open < 0 {
+		return nil
+	}
+	var vals []string
+	var cur strings.Builder
+	quoted := false
+	s := columnType[open+1:]
+	for i := 0; i < len(s); i++ {
+		ch := s[i]
+		switch {
+		case quoted && ch == '\'' && i+1 < len(s) && s[i+1] == '\'':
+			cur.WriteByte('\'')
+			i++
+		case quoted && ch == '\\' && i+1 < len(s):
+			cur.WriteByte(s[i+1])
+			i++
+		case ch == '\'':
+			if quoted {
+				vals = append(vals, cur.String())
+				cur.Reset()
+			}
+			quoted = !quoted
+		case quoted:
+			cur.WriteByte(ch)
+		case ch == ')':
+			return vals
+		}
+	}
+	return vals
+}
@@ -0,0 +1,77 @@
+package introspect
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+)
+
+func loadSQLite(ctx context.Context, db *sql.DB) ([]*Table, error) {
+	rows, err := db.QueryContext(ctx, `
+		SELECT type, name, coalesce(sql, '')
+		FROM sqlite_schema
+		WHERE type IN ('table', 'view') AND name NOT LIKE 'sqlite\_%' ESCAPE '\'
+		ORDER BY rowid`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var tables []*Table
+	for rows.Next() {
+		var typ string
+		t := &Table{}
+		if err := rows.Scan(&typ, &t.Name, &t.SQL); err != nil {
+			return nil, err
+		}
+		t.View = typ == "view"
+		tables = append(tables, t)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	for _, t := range tables {
+		cols, err := sqliteColumns(ctx, db, t.Name)
+		if err != nil {
+			return nil, err
+		}
+		t.Columns = cols
+	}
+	return tables, nil
+}
+
+func sqliteColumns(ctx context.Context, db *sql.DB, table string) ([]*Column, error) {
+	rows, err := db.QueryContext(ctx, `SELECT name, type, "notnull", pk FROM pragma_table_info(?) ORDER BY cid`, table)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var cols []*Column
+	for rows.Next() {
+		var name, typ string
+		var notNull, pk int
+		if err := rows.Scan(&name, &typ, &notNull, &pk); err != nil {
+			return nil, err
+		}
+		// Match the SQLite parser, which drops the whitespace between the
+		// words of a type name and treats columns without one as "any"
+		converted := strings.Join(strings.Fields(typ), "")
+		if converted == "" {
+			converted = "any"
+		}
+		cols = append(cols, &Column{
+			Name:         strings.ToLower(name),
+			Type:         converted,
+			NotNull:      notNull != 0 || pk > 0,
+			PrimaryKey:   pk > 0,
+			DeclaredType: typ,
+		})
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	return cols, rows.Err()
+}
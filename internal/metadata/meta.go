@@ -2,6 +2,7 @@ package metadata
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 	"unicode"
 )
@@ -17,14 +18,48 @@ const (
 	CmdExecResult = ":execresult"
 	CmdExecRows   = ":execrows"
 	CmdExecLastId = ":execlastid"
+	CmdPatch      = ":patch"
 	CmdMany       = ":many"
+	CmdIter       = ":iter"
+	CmdMap        = ":map"
+	CmdPaginate   = ":paginate"
 	CmdOne        = ":one"
+	CmdOpt        = ":opt"
 	CmdCopyFrom   = ":copyfrom"
 	CmdBatchExec  = ":batchexec"
 	CmdBatchMany  = ":batchmany"
 	CmdBatchOne   = ":batchone"
 )
 
+// Options that can follow each query type, written as name=value, and
+// whether each one is required
+var cmdOptions = map[string]map[string]bool{
+	CmdOne:       {"primary": false},
+	CmdOpt:       {"primary": false},
+	CmdIter:      {"primary": false},
+	CmdPaginate:  {"primary": false},
+	CmdMap:       {"key": true, "primary": false},
+	CmdMany:      {"chunk": false, "primary": false},
+	CmdExec:      {"chunk": false},
+	CmdExecRows:  {"chunk": false},
+	CmdCopyFrom:  {"tx": false, "method": false},
+	CmdBatchExec: {"tx": false},
+	CmdBatchMany: {"tx": false},
+	CmdBatchOne:  {"tx": false},
+}
+
+// The values allowed for options that aren't free form
+var optionValues = map[string][]string{
+	"tx":      {"true", "false"},
+	"method":  {"insert", "load_data"},
+	"primary": {"true", "false"},
+}
+
+// Options whose value is a positive number
+var numberOptions = map[string]bool{
+	"chunk": true,
+}
+
 // A query name must be a valid Go identifier
 //
 // https://golang.org/ref/spec#Identifiers
@@ -44,7 +79,9 @@ func validateQueryName(name string) error {
 	return nil
 }
 
-func Parse(t string, commentStyle CommentSyntax) (string, string, error) {
+// Parse returns the name, type and options of the query described by the
+// metadata comment in t.
+func Parse(t string, commentStyle CommentSyntax) (string, string, []string, error) {
 	for _, line := range strings.Split(t, "\n") {
 		var prefix string
 		if strings.HasPrefix(line, "--") {
@@ -76,7 +113,7 @@ func Parse(t string, commentStyle CommentSyntax) (string, string, error) {
 			continue
 		}
 		if !strings.HasPrefix(rest, " name: ") {
-			return "", "", fmt.Errorf("invalid metadata: %s", line)
+			return "", "", nil, fmt.Errorf("invalid metadata: %s", line)
 		}
 
 		part := strings.Split(strings.TrimSpace(line), " ")
@@ -84,22 +121,69 @@ func Parse(t string, commentStyle CommentSyntax) (string, string, error) {
 			part = part[:len(part)-1] // removes the trailing "*/" element
 		}
 		if len(part) == 2 {
-			return "", "", fmt.Errorf("missing query type [':one', ':many', ':exec', ':execrows', ':execlastid', ':execresult', ':copyfrom', 'batchexec', 'batchmany', 'batchone']: %s", line)
+			return "", "", nil, fmt.Errorf("missing query type [':one', ':opt', ':many', ':iter', ':map', ':paginate', ':exec', ':execrows', ':execlastid', ':execresult', ':patch', ':copyfrom', 'batchexec', 'batchmany', 'batchone']: %s", line)
 		}
-		if len(part) != 4 {
-			return "", "", fmt.Errorf("invalid query comment: %s", line)
+		if len(part) < 4 {
+			return "", "", nil, fmt.Errorf("invalid query comment: %s", line)
 		}
 		queryName := part[2]
 		queryType := strings.TrimSpace(part[3])
 		switch queryType {
-		case CmdOne, CmdMany, CmdExec, CmdExecResult, CmdExecRows, CmdExecLastId, CmdCopyFrom, CmdBatchExec, CmdBatchMany, CmdBatchOne:
+		case CmdOne, CmdOpt, CmdMany, CmdIter, CmdMap, CmdPaginate, CmdExec, CmdExecResult, CmdExecRows, CmdExecLastId, CmdPatch, CmdCopyFrom, CmdBatchExec, CmdBatchMany, CmdBatchOne:
 		default:
-			return "", "", fmt.Errorf("invalid query type: %s", queryType)
+			return "", "", nil, fmt.Errorf("invalid query type: %s", queryType)
 		}
 		if err := validateQueryName(queryName); err != nil {
-			return "", "", err
+			return "", "", nil, err
+		}
+		options := part[4:]
+		for _, opt := range options {
+			if err := validateOption(queryType, opt); err != nil {
+				return "", "", nil, fmt.Errorf("%w: %s", err, line)
+			}
+		}
+		for name, required := range cmdOptions[queryType] {
+			if _, ok := Option(options, name); required && !ok {
+				return "", "", nil, fmt.Errorf("query type %s requires the %s option: %s", queryType, name, line)
+			}
+		}
+		return queryName, queryType, options, nil
+	}
+	return "", "", nil, nil
+}
+
+func validateOption(cmd, opt string) error {
+	name, value, ok := strings.Cut(opt, "=")
+	if !ok || value == "" {
+		return fmt.Errorf("invalid query option %q", opt)
+	}
+	if _, ok := cmdOptions[cmd][name]; !ok {
+		return fmt.Errorf("query type %s has no %s option", cmd, name)
+	}
+	if numberOptions[name] {
+		if n, err := strconv.Atoi(value); err != nil || n < 1 {
+			return fmt.Errorf("invalid value %q for query option %s, expected a positive number", value, name)
+		}
+		return nil
+	}
+	allowed, ok := optionValues[name]
+	if !ok {
+		return nil
+	}
+	for _, v := range allowed {
+		if v == value {
+			return nil
+		}
+	}
+	return fmt.Errorf("invalid value %q for query option %s, expected one of %s", value, name, strings.Join(allowed, ", "))
+}
+
+// Option returns the value of the named query option.
+func Option(options []string, name string) (string, bool) {
+	for _, opt := range options {
+		if n, value, ok := strings.Cut(opt, "="); ok && n == name {
+			return value, true
 		}
-		return queryName, queryType, nil
 	}
-	return "", "", nil
+	return "", false
 }
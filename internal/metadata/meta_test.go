@@ -16,8 +16,20 @@ func TestParseMetadata(t *testing.T) {
 		`--name: CreateFoo :two`,
 		"-- name:CreateFoo",
 		`--name:CreateFoo :two`,
+		`-- name: ListFoo :map`,
+		`-- name: ListFoo :map key`,
+		`-- name: ListFoo :many key=id`,
+		`-- name: ListFoo :map id=key`,
+		`-- name: CreateFoo :copyfrom tx=yes`,
+		`-- name: CreateFoo :copyfrom method=copy`,
+		`-- name: CreateFoo :batchexec method=load_data`,
+		`-- name: ListFoo :many chunk=0`,
+		`-- name: ListFoo :many chunk=all`,
+		`-- name: GetFoo :one chunk=100`,
+		`-- name: GetFoo :one primary=yes`,
+		`-- name: UpdateFoo :exec primary=true`,
 	} {
-		if _, _, err := Parse(query, CommentSyntax{Dash: true}); err == nil {
+		if _, _, _, err := Parse(query, CommentSyntax{Dash: true}); err == nil {
 			t.Errorf("expected invalid metadata: %q", query)
 		}
 	}
@@ -27,13 +39,13 @@ func TestParseMetadata(t *testing.T) {
 		`-- name comment`,
 		`--name comment`,
 	} {
-		if _, _, err := Parse(query, CommentSyntax{Dash: true}); err != nil {
+		if _, _, _, err := Parse(query, CommentSyntax{Dash: true}); err != nil {
 			t.Errorf("expected valid comment: %q", query)
 		}
 	}
 
 	query := `-- name: CreateFoo :one`
-	queryName, queryType, err := Parse(query, CommentSyntax{Dash: true})
+	queryName, queryType, _, err := Parse(query, CommentSyntax{Dash: true})
 	if err != nil {
 		t.Errorf("expected valid metadata: %q", query)
 	}
@@ -44,4 +56,42 @@ func TestParseMetadata(t *testing.T) {
 		t.Errorf("incorrect queryType parsed: %q", query)
 	}
 
+	query = `-- name: ListFoo :map key=id`
+	_, queryType, options, err := Parse(query, CommentSyntax{Dash: true})
+	if err != nil {
+		t.Errorf("expected valid metadata: %q", query)
+	}
+	if queryType != CmdMap {
+		t.Errorf("incorrect queryType parsed: %q", query)
+	}
+	if key, _ := Option(options, "key"); key != "id" {
+		t.Errorf("incorrect key option parsed: %q", query)
+	}
+
+	query = `-- name: CreateFoo :copyfrom tx=true method=load_data`
+	_, _, options, err = Parse(query, CommentSyntax{Dash: true})
+	if err != nil {
+		t.Errorf("expected valid metadata: %q", query)
+	}
+	if method, _ := Option(options, "method"); method != "load_data" {
+		t.Errorf("incorrect method option parsed: %q", query)
+	}
+
+	query = `-- name: CreateFoo :batchone tx=true`
+	_, _, options, err = Parse(query, CommentSyntax{Dash: true})
+	if err != nil {
+		t.Errorf("expected valid metadata: %q", query)
+	}
+	if tx, _ := Option(options, "tx"); tx != "true" {
+		t.Errorf("incorrect tx option parsed: %q", query)
+	}
+
+	query = `-- name: ListFoo :many chunk=100 primary=true`
+	_, _, options, err = Parse(query, CommentSyntax{Dash: true})
+	if err != nil {
+		t.Errorf("expected valid metadata: %q", query)
+	}
+	if primary, _ := Option(options, "primary"); primary != "true" {
+		t.Errorf("incorrect primary option parsed: %q", query)
+	}
 }
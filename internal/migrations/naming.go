@@ -0,0 +1,69 @@
+package migrations
+
+import (
+	"fmt"
+	"math/big"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// timestampFormat is the version format atlas and golang-migrate use for
+// migrations created from the command line
+const timestampFormat = "20060102150405"
+
+// FileNames returns the names of the files holding a new migration, named the
+// way the tool names them and versioned after the existing files. The down
+// file name is empty for tools that don't keep rollbacks in a separate file.
+//
+// atlas:          20230101120000_name.sql
+// flyway:         V3__name.sql, U3__name.sql
+// golang-migrate: 000003_name.up.sql, 000003_name.down.sql
+// liquibase:      20230101120000_name.sql
+func FileNames(tool string, files []string, name string, now time.Time) (up, down string, err error) {
+	stamp := now.UTC().Format(timestampFormat)
+	switch tool {
+	case "", Atlas, Liquibase:
+		return stamp + "_" + name + ".sql", "", nil
+	case Flyway:
+		next := nextVersion(files, flywayVersion)
+		return fmt.Sprintf("V%s__%s.sql", next, name), fmt.Sprintf("U%s__%s.sql", next, name), nil
+	case GolangMigrate:
+		next := nextVersion(files, golangMigrateVersion)
+		if next == "1" {
+			next = "000001"
+		}
+		if len(next) >= len(timestampFormat) {
+			// Versions are timestamps, as created by migrate create
+			next = stamp
+		}
+		return fmt.Sprintf("%s_%s.up.sql", next, name), fmt.Sprintf("%s_%s.down.sql", next, name), nil
+	default:
+		return "", "", fmt.Errorf("unknown migrations tool %q", tool)
+	}
+}
+
+// nextVersion returns the version after the highest major version of the
+// files, zero-padded to the width of that version
+func nextVersion(files []string, version func(string) ([]string, bool)) string {
+	var last string
+	for _, file := range files {
+		v, ok := version(filepath.Base(file))
+		if !ok {
+			continue
+		}
+		if last == "" || compareVersions(v[:1], []string{last}) > 0 {
+			last = v[0]
+		}
+	}
+	if last == "" {
+		return "1"
+	}
+	n, _ := new(big.Int).SetString(last, 10)
+	n.Add(n, big.NewInt(1))
+	next := n.String()
+	if pad := len(last) - len(next); pad > 0 {
+		next = strings.Repeat("0", pad) + next
+	}
+	return next
+}
@@ -0,0 +1,32 @@
+package migrations
+
+import (
+	"testing"
+	"time"
+)
+
+func TestFileNames(t *testing.T) {
+	now := time.Date(2023, 1, 2, 3, 4, 5, 0, time.UTC)
+	for _, test := range []struct {
+		tool     string
+		files    []string
+		up, down string
+	}{
+		{Flyway, nil, "V1__add.sql", "U1__add.sql"},
+		{Flyway, []string{"V1__a.sql", "V2.1__b.sql", "U2__b.sql", "V9__c.sql"}, "V10__add.sql", "U10__add.sql"},
+		{GolangMigrate, nil, "000001_add.up.sql", "000001_add.down.sql"},
+		{GolangMigrate, []string{"000009_a.up.sql", "000009_a.down.sql"}, "000010_add.up.sql", "000010_add.down.sql"},
+		{GolangMigrate, []string{"20220101000000_a.up.sql"}, "20230102030405_add.up.sql", "20230102030405_add.down.sql"},
+		{Atlas, []string{"20220101000000_a.sql"}, "20230102030405_add.sql", ""},
+		{Liquibase, nil, "20230102030405_add.sql", ""},
+	} {
+		up, down, err := FileNames(test.tool, test.files, "add", now)
+		if err != nil {
+			t.Errorf("%s: %s", test.tool, err)
+			continue
+		}
+		if up != test.up || down != test.down {
+			t.Errorf("%s %v: got %s and %s, want %s and %s", test.tool, test.files, up, down, test.up, test.down)
+		}
+	}
+}
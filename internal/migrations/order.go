@@ -0,0 +1,199 @@
+package migrations
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// Migration tools with their own rules for naming and ordering files.
+const (
+	Atlas         = "atlas"
+	Flyway        = "flyway"
+	GolangMigrate = "golang-migrate"
+	Liquibase     = "liquibase"
+)
+
+// IsTool reports whether files for the named tool can be ordered.
+func IsTool(name string) bool {
+	switch name {
+	case Atlas, Flyway, GolangMigrate, Liquibase:
+		return true
+	}
+	return false
+}
+
+// Order returns the schema files the named tool would apply, in the order it
+// would apply them. Undo and repeatable migrations are left out. Without a
+// tool, files are returned unchanged.
+//
+// atlas:          files listed in atlas.sum, else 1_init.sql, 2_users.sql
+// flyway:         V1__init.sql, V1.1__users.sql, V2__teams.sql
+// golang-migrate: 1_init.up.sql, 2_users.up.sql
+// liquibase:      changelog files in path order, as with includeAll
+func Order(tool string, files []string) ([]string, error) {
+	switch tool {
+	case "":
+		return files, nil
+	case Atlas:
+		return orderAtlas(files)
+	case Flyway:
+		return orderVersioned(tool, files, flywayVersion)
+	case GolangMigrate:
+		return orderVersioned(tool, files, golangMigrateVersion)
+	case Liquibase:
+		ordered := append([]string{}, files...)
+		sort.Strings(ordered)
+		return ordered, nil
+	default:
+		return nil, fmt.Errorf("unknown migrations tool %q", tool)
+	}
+}
+
+var flywayFile = regexp.MustCompile(`^V([0-9]+(?:[._][0-9]+)*)__.*\.sql$`)
+
+// flywayVersion returns the version of a versioned migration. Undo (U),
+// repeatable (R) and other files have no version.
+func flywayVersion(filename string) ([]string, bool) {
+	m := flywayFile.FindStringSubmatch(filepath.Base(filename))
+	if m == nil {
+		return nil, false
+	}
+	return strings.FieldsFunc(m[1], func(r rune) bool { return r == '.' || r == '_' }), true
+}
+
+var golangMigrateFile = regexp.MustCompile(`^([0-9]+)(?:_.*)?\.up\.sql$`)
+
+func golangMigrateVersion(filename string) ([]string, bool) {
+	m := golangMigrateFile.FindStringSubmatch(filepath.Base(filename))
+	if m == nil {
+		return nil, false
+	}
+	return []string{m[1]}, true
+}
+
+func orderVersioned(tool string, files []string, version func(string) ([]string, bool)) ([]string, error) {
+	type migration struct {
+		file    string
+		version []string
+	}
+	var ms []migration
+	for _, file := range files {
+		if v, ok := version(file); ok {
+			ms = append(ms, migration{file, v})
+		}
+	}
+	sort.SliceStable(ms, func(i, j int) bool {
+		return compareVersions(ms[i].version, ms[j].version) < 0
+	})
+	ordered := make([]string, len(ms))
+	for i, m := range ms {
+		if i > 0 && compareVersions(ms[i-1].version, m.version) == 0 {
+			return nil, fmt.Errorf("%s: %s and %s have the same version", tool, filepath.Base(ms[i-1].file), filepath.Base(m.file))
+		}
+		ordered[i] = m.file
+	}
+	return ordered, nil
+}
+
+// compareVersions compares two versions part by part, treating each part as
+// a number of any size. Missing parts count as zero, so 1 and 1.0 are equal.
+func compareVersions(a, b []string) int {
+	for i := 0; i < len(a) || i < len(b); i++ {
+		var x, y string
+		if i < len(a) {
+			x = strings.TrimLeft(a[i], "0")
+		}
+		if i < len(b) {
+			y = strings.TrimLeft(b[i], "0")
+		}
+		if len(x) != len(y) {
+			if len(x) < len(y) {
+				return -1
+			}
+			return 1
+		}
+		if c := strings.Compare(x, y); c != 0 {
+			return c
+		}
+	}
+	return 0
+}
+
+// orderAtlas orders the files in each directory as listed in the directory's
+// atlas.sum file. Directories without one are ordered by the version that
+// prefixes each file name.
+func orderAtlas(files []string) ([]string, error) {
+	var dirs []string
+	byDir := map[string][]string{}
+	for _, file := range files {
+		dir := filepath.Dir(file)
+		if _, ok := byDir[dir]; !ok {
+			dirs = append(dirs, dir)
+		}
+		byDir[dir] = append(byDir[dir], file)
+	}
+	var ordered []string
+	for _, dir := range dirs {
+		sum, err := readAtlasSum(filepath.Join(dir, "atlas.sum"))
+		if os.IsNotExist(err) {
+			out, err := orderVersioned(Atlas, byDir[dir], atlasVersion)
+			if err != nil {
+				return nil, err
+			}
+			ordered = append(ordered, out...)
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		present := map[string]string{}
+		for _, file := range byDir[dir] {
+			present[filepath.Base(file)] = file
+		}
+		for _, name := range sum {
+			if file, ok := present[name]; ok {
+				ordered = append(ordered, file)
+				delete(present, name)
+			}
+		}
+		for _, file := range byDir[dir] {
+			if _, ok := present[filepath.Base(file)]; ok {
+				return nil, fmt.Errorf("atlas: %s is not listed in %s; run atlas migrate hash", filepath.Base(file), filepath.Join(dir, "atlas.sum"))
+			}
+		}
+	}
+	return ordered, nil
+}
+
+func atlasVersion(filename string) ([]string, bool) {
+	version, _, _ := strings.Cut(filepath.Base(filename), "_")
+	version = strings.TrimSuffix(version, ".sql")
+	return []string{version}, true
+}
+
+// readAtlasSum returns the file names listed in an atlas.sum file. The first
+// line holds the checksum of the directory, and every other line a file name
+// followed by its checksum.
+func readAtlasSum(path string) ([]string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	var names []string
+	s := bufio.NewScanner(f)
+	for first := true; s.Scan(); first = false {
+		if first {
+			continue
+		}
+		if i := strings.LastIndexByte(s.Text(), ' '); i > 0 {
+			names = append(names, s.Text()[:i])
+		}
+	}
+	return names, s.Err()
+}
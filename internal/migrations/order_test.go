@@ -0,0 +1,78 @@
+package migrations
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestOrder(t *testing.T) {
+	for _, test := range []struct {
+		tool  string
+		files []string
+		want  []string
+	}{
+		{
+			Flyway,
+			[]string{"V10__c.sql", "V1_1__b.sql", "V1__a.sql", "V2__d.sql", "R__views.sql", "U2__undo_d.sql", "schema.sql"},
+			[]string{"V1__a.sql", "V1_1__b.sql", "V2__d.sql", "V10__c.sql"},
+		},
+		{
+			GolangMigrate,
+			[]string{"10_c.up.sql", "2_b.up.sql", "000001_a.up.sql", "readme.sql"},
+			[]string{"000001_a.up.sql", "2_b.up.sql", "10_c.up.sql"},
+		},
+		{
+			Atlas,
+			[]string{"10_c.sql", "2_b.sql", "1_a.sql"},
+			[]string{"1_a.sql", "2_b.sql", "10_c.sql"},
+		},
+		{
+			Liquibase,
+			[]string{"b/changelog.sql", "a/changelog.sql"},
+			[]string{"a/changelog.sql", "b/changelog.sql"},
+		},
+		{
+			"",
+			[]string{"V10__c.sql", "V2__b.sql"},
+			[]string{"V10__c.sql", "V2__b.sql"},
+		},
+	} {
+		got, err := Order(test.tool, test.files)
+		if err != nil {
+			t.Errorf("%s: %s", test.tool, err)
+			continue
+		}
+		if diff := cmp.Diff(test.want, got); diff != "" {
+			t.Errorf("%s order mismatch:\n%s", test.tool, diff)
+		}
+	}
+}
+
+func TestOrderDuplicateVersion(t *testing.T) {
+	if _, err := Order(Flyway, []string{"V1__a.sql", "V1.0__b.sql"}); err == nil {
+		t.Errorf("expected an error for two migrations with the same version")
+	}
+}
+
+func TestOrderAtlasSum(t *testing.T) {
+	dir := t.TempDir()
+	sum := "h1:dir=\n20230102_b.sql h1:b=\n20230101_a.sql h1:a=\n"
+	if err := os.WriteFile(filepath.Join(dir, "atlas.sum"), []byte(sum), 0644); err != nil {
+		t.Fatal(err)
+	}
+	a := filepath.Join(dir, "20230101_a.sql")
+	b := filepath.Join(dir, "20230102_b.sql")
+	got, err := Order(Atlas, []string{a, b})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff([]string{b, a}, got); diff != "" {
+		t.Errorf("atlas.sum order mismatch:\n%s", diff)
+	}
+	if _, err := Order(Atlas, []string{a, b, filepath.Join(dir, "20230103_c.sql")}); err == nil {
+		t.Errorf("expected an error for a file missing from atlas.sum")
+	}
+}
@@ -11,12 +11,16 @@ import (
 //     dumpast: setting dumpast=1 will print the AST of every SQL statement
 //     dumpcatalog: setting dumpcatalog=1 will print the parsed database schema
 //     trace: setting trace=<path> will output a trace
+//     catalogcache: setting catalogcache=0 will disable the catalog snapshot cache
+//
+// Setting any of them also reports catalog snapshots that can't be stored.
 
 type Debug struct {
 	DumpAST        bool
 	DumpCatalog    bool
 	Trace          string
 	ProcessPlugins bool
+	CatalogCache   bool
 }
 
 func DebugFromEnv() Debug {
@@ -26,6 +30,7 @@ func DebugFromEnv() Debug {
 func DebugFromString(val string) Debug {
 	d := Debug{
 		ProcessPlugins: true,
+		CatalogCache:   true,
 	}
 	if val == "" {
 		return d
@@ -46,6 +51,8 @@ func DebugFromString(val string) Debug {
 			}
 		case pair == "processplugins=0":
 			d.ProcessPlugins = false
+		case pair == "catalogcache=0":
+			d.CatalogCache = false
 		}
 	}
 	return d
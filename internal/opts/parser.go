@@ -1,5 +1,6 @@
 package opts
 
 type Parser struct {
-	Debug Debug
+	Debug      Debug
+	NoDatabase bool
 }
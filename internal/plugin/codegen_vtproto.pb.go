@@ -195,6 +195,9 @@ func (m *GoCode) CloneVT() *GoCode {
 		OutputBatchFileName:       m.OutputBatchFileName,
 		JsonTagsIdUppercase:       m.JsonTagsIdUppercase,
 		OmitUnusedStructs:         m.OmitUnusedStructs,
+		EmitTxHelpers:             m.EmitTxHelpers,
+		EmitReadReplica:           m.EmitReadReplica,
+		EmitHooks:                 m.EmitHooks,
 	}
 	if rhs := m.InflectionExcludeTableNames; rhs != nil {
 		tmpContainer := make([]string, len(rhs))
@@ -397,21 +400,24 @@ func (m *Column) CloneVT() *Column {
 		return (*Column)(nil)
 	}
 	r := &Column{
-		Name:         m.Name,
-		NotNull:      m.NotNull,
-		IsArray:      m.IsArray,
-		Comment:      m.Comment,
-		Length:       m.Length,
-		IsNamedParam: m.IsNamedParam,
-		IsFuncCall:   m.IsFuncCall,
-		Scope:        m.Scope,
-		Table:        m.Table.CloneVT(),
-		TableAlias:   m.TableAlias,
-		Type:         m.Type.CloneVT(),
-		IsSqlcSlice:  m.IsSqlcSlice,
-		EmbedTable:   m.EmbedTable.CloneVT(),
-		OriginalName: m.OriginalName,
-		Unsigned:     m.Unsigned,
+		Name:           m.Name,
+		NotNull:        m.NotNull,
+		IsArray:        m.IsArray,
+		Comment:        m.Comment,
+		Length:         m.Length,
+		IsNamedParam:   m.IsNamedParam,
+		IsFuncCall:     m.IsFuncCall,
+		Scope:          m.Scope,
+		Table:          m.Table.CloneVT(),
+		TableAlias:     m.TableAlias,
+		Type:           m.Type.CloneVT(),
+		IsSqlcSlice:    m.IsSqlcSlice,
+		EmbedTable:     m.EmbedTable.CloneVT(),
+		OriginalName:   m.OriginalName,
+		Unsigned:       m.Unsigned,
+		IsPrimaryKey:   m.IsPrimaryKey,
+		EmbedMany:      m.EmbedMany,
+		IsSqlcOptional: m.IsSqlcOptional,
 	}
 	if len(m.unknownFields) > 0 {
 		r.unknownFields = make([]byte, len(m.unknownFields))
@@ -454,6 +460,11 @@ func (m *Query) CloneVT() *Query {
 		copy(tmpContainer, rhs)
 		r.Comments = tmpContainer
 	}
+	if rhs := m.Options; rhs != nil {
+		tmpContainer := make([]string, len(rhs))
+		copy(tmpContainer, rhs)
+		r.Options = tmpContainer
+	}
 	if len(m.unknownFields) > 0 {
 		r.unknownFields = make([]byte, len(m.unknownFields))
 		copy(r.unknownFields, m.unknownFields)
@@ -911,6 +922,15 @@ func (this *GoCode) EqualVT(that *GoCode) bool {
 	if this.OmitUnusedStructs != that.OmitUnusedStructs {
 		return false
 	}
+	if this.EmitTxHelpers != that.EmitTxHelpers {
+		return false
+	}
+	if this.EmitReadReplica != that.EmitReadReplica {
+		return false
+	}
+	if this.EmitHooks != that.EmitHooks {
+		return false
+	}
 	return string(this.unknownFields) == string(that.unknownFields)
 }
 
@@ -1229,6 +1249,15 @@ func (this *Column) EqualVT(that *Column) bool {
 	if this.Unsigned != that.Unsigned {
 		return false
 	}
+	if this.IsPrimaryKey != that.IsPrimaryKey {
+		return false
+	}
+	if this.EmbedMany != that.EmbedMany {
+		return false
+	}
+	if this.IsSqlcOptional != that.IsSqlcOptional {
+		return false
+	}
 	return string(this.unknownFields) == string(that.unknownFields)
 }
 
@@ -1303,6 +1332,15 @@ func (this *Query) EqualVT(that *Query) bool {
 	if !this.InsertIntoTable.EqualVT(that.InsertIntoTable) {
 		return false
 	}
+	if len(this.Options) != len(that.Options) {
+		return false
+	}
+	for i, vx := range this.Options {
+		vy := that.Options[i]
+		if vx != vy {
+			return false
+		}
+	}
 	return string(this.unknownFields) == string(that.unknownFields)
 }
 
@@ -1955,6 +1993,42 @@ func (m *GoCode) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 		i -= len(m.unknownFields)
 		copy(dAtA[i:], m.unknownFields)
 	}
+	if m.EmitHooks {
+		i--
+		if m.EmitHooks {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xf0
+	}
+	if m.EmitReadReplica {
+		i--
+		if m.EmitReadReplica {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xe8
+	}
+	if m.EmitTxHelpers {
+		i--
+		if m.EmitTxHelpers {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xe0
+	}
 	if m.OmitUnusedStructs {
 		i--
 		if m.OmitUnusedStructs {
@@ -2668,6 +2742,42 @@ func (m *Column) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 		i -= len(m.unknownFields)
 		copy(dAtA[i:], m.unknownFields)
 	}
+	if m.IsSqlcOptional {
+		i--
+		if m.IsSqlcOptional {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x98
+	}
+	if m.EmbedMany {
+		i--
+		if m.EmbedMany {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
+	}
+	if m.IsPrimaryKey {
+		i--
+		if m.IsPrimaryKey {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
 	if m.Unsigned {
 		i--
 		if m.Unsigned {
@@ -2833,6 +2943,15 @@ func (m *Query) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 		i -= len(m.unknownFields)
 		copy(dAtA[i:], m.unknownFields)
 	}
+	if len(m.Options) > 0 {
+		for iNdEx := len(m.Options) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Options[iNdEx])
+			copy(dAtA[i:], m.Options[iNdEx])
+			i = encodeVarint(dAtA, i, uint64(len(m.Options[iNdEx])))
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
 	if m.InsertIntoTable != nil {
 		size, err := m.InsertIntoTable.MarshalToSizedBufferVT(dAtA[:i])
 		if err != nil {
@@ -3700,6 +3819,42 @@ func (m *GoCode) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error) {
 		i -= len(m.unknownFields)
 		copy(dAtA[i:], m.unknownFields)
 	}
+	if m.EmitHooks {
+		i--
+		if m.EmitHooks {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xf0
+	}
+	if m.EmitReadReplica {
+		i--
+		if m.EmitReadReplica {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xe8
+	}
+	if m.EmitTxHelpers {
+		i--
+		if m.EmitTxHelpers {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xe0
+	}
 	if m.OmitUnusedStructs {
 		i--
 		if m.OmitUnusedStructs {
@@ -4413,6 +4568,42 @@ func (m *Column) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error) {
 		i -= len(m.unknownFields)
 		copy(dAtA[i:], m.unknownFields)
 	}
+	if m.IsSqlcOptional {
+		i--
+		if m.IsSqlcOptional {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x98
+	}
+	if m.EmbedMany {
+		i--
+		if m.EmbedMany {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
+	}
+	if m.IsPrimaryKey {
+		i--
+		if m.IsPrimaryKey {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
 	if m.Unsigned {
 		i--
 		if m.Unsigned {
@@ -4578,6 +4769,15 @@ func (m *Query) MarshalToSizedBufferVTStrict(dAtA []byte) (int, error) {
 		i -= len(m.unknownFields)
 		copy(dAtA[i:], m.unknownFields)
 	}
+	if len(m.Options) > 0 {
+		for iNdEx := len(m.Options) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Options[iNdEx])
+			copy(dAtA[i:], m.Options[iNdEx])
+			i = encodeVarint(dAtA, i, uint64(len(m.Options[iNdEx])))
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
 	if m.InsertIntoTable != nil {
 		size, err := m.InsertIntoTable.MarshalToSizedBufferVTStrict(dAtA[:i])
 		if err != nil {
@@ -5262,6 +5462,15 @@ func (m *GoCode) SizeVT() (n int) {
 	if m.OmitUnusedStructs {
 		n += 3
 	}
+	if m.EmitTxHelpers {
+		n += 3
+	}
+	if m.EmitReadReplica {
+		n += 3
+	}
+	if m.EmitHooks {
+		n += 3
+	}
 	n += len(m.unknownFields)
 	return n
 }
@@ -5499,6 +5708,15 @@ func (m *Column) SizeVT() (n int) {
 	if m.Unsigned {
 		n += 3
 	}
+	if m.IsPrimaryKey {
+		n += 3
+	}
+	if m.EmbedMany {
+		n += 3
+	}
+	if m.IsSqlcOptional {
+		n += 3
+	}
 	n += len(m.unknownFields)
 	return n
 }
@@ -5547,6 +5765,12 @@ func (m *Query) SizeVT() (n int) {
 		l = m.InsertIntoTable.SizeVT()
 		n += 1 + l + sov(uint64(l))
 	}
+	if len(m.Options) > 0 {
+		for _, s := range m.Options {
+			l = len(s)
+			n += 1 + l + sov(uint64(l))
+		}
+	}
 	n += len(m.unknownFields)
 	return n
 }
@@ -7693,6 +7917,66 @@ func (m *GoCode) UnmarshalVT(dAtA []byte) error {
 				}
 			}
 			m.OmitUnusedStructs = bool(v != 0)
+		case 28:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EmitTxHelpers", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.EmitTxHelpers = bool(v != 0)
+		case 29:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EmitReadReplica", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.EmitReadReplica = bool(v != 0)
+		case 30:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EmitHooks", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.EmitHooks = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skip(dAtA[iNdEx:])
@@ -9258,6 +9542,66 @@ func (m *Column) UnmarshalVT(dAtA []byte) error {
 				}
 			}
 			m.Unsigned = bool(v != 0)
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsPrimaryKey", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsPrimaryKey = bool(v != 0)
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EmbedMany", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.EmbedMany = bool(v != 0)
+		case 19:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsSqlcOptional", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsSqlcOptional = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skip(dAtA[iNdEx:])
@@ -9573,6 +9917,38 @@ func (m *Query) UnmarshalVT(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Options", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Options = append(m.Options, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skip(dAtA[iNdEx:])
@@ -0,0 +1,208 @@
+package schemadiff
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/ZeyuRemtes/sqlc/internal/engine/sqlite"
+	"github.com/ZeyuRemtes/sqlc/internal/sql/catalog"
+)
+
+// dialect renders statements for one engine
+type dialect struct {
+	quote     func(string) string
+	columnDef func(*catalog.Column, map[string]*catalog.Enum) string
+	dropIndex func(qualifier string, t *catalog.Table, idx *catalog.Index) string
+
+	// primaryKey renders the column constraint making col the primary key
+	primaryKey func(col *catalog.Column) string
+
+	// Optional: engines without them can't change a table's comment, and
+	// alter changed columns in place instead of rebuilding the table
+	tableComment func(table, comment string) string
+	rebuild      func(d *schemaDiff, old, t *catalog.Table) []string
+
+	// Engines that can't fill a new NOT NULL column of existing rows
+	// without a default
+	addNeedsDefault bool
+}
+
+func (d *dialect) tableName(qualifier, name string) string {
+	if qualifier == "" {
+		return d.quote(name)
+	}
+	return d.quote(qualifier) + "." + d.quote(name)
+}
+
+func (d *dialect) createTable(qualifier, name string, t *catalog.Table, enums map[string]*catalog.Enum) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "CREATE TABLE %s (\n", d.tableName(qualifier, name))
+	keys := primaryKey(t)
+	for i, col := range t.Columns {
+		fmt.Fprintf(&b, "  %s", d.columnDef(col, enums))
+		// A key of a single column is declared with the column, as SQLite
+		// only allows AUTOINCREMENT there
+		if len(keys) == 1 && col.IsPrimaryKey {
+			b.WriteString(d.primaryKey(col))
+		}
+		if i < len(t.Columns)-1 || len(keys) > 1 {
+			b.WriteString(",")
+		}
+		b.WriteString("\n")
+	}
+	if len(keys) > 1 {
+		fmt.Fprintf(&b, "  PRIMARY KEY (%s)\n", d.columnList(keys))
+	}
+	b.WriteString(")")
+	if t.Comment != "" && d.tableComment != nil {
+		fmt.Fprintf(&b, " COMMENT %s", literal(t.Comment))
+	}
+	b.WriteString(";")
+	return b.String()
+}
+
+func (d *dialect) columnList(names []string) string {
+	var cols []string
+	for _, name := range names {
+		cols = append(cols, d.quote(name))
+	}
+	return strings.Join(cols, ", ")
+}
+
+// primaryKey returns the names of the columns making up the primary key of t
+func primaryKey(t *catalog.Table) []string {
+	var keys []string
+	for _, col := range t.Columns {
+		if col.IsPrimaryKey {
+			keys = append(keys, col.Name)
+		}
+	}
+	return keys
+}
+
+func (d *dialect) createIndex(qualifier string, t *catalog.Table, idx *catalog.Index) string {
+	unique := ""
+	if idx.Unique {
+		unique = "UNIQUE "
+	}
+	return fmt.Sprintf("CREATE %sINDEX %s ON %s (%s);", unique, d.quote(idx.Name), d.tableName(qualifier, t.Rel.Name), d.columnList(idx.Columns))
+}
+
+func literal(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", "''") + "'"
+}
+
+func mysqlDialect() *dialect {
+	d := &dialect{}
+	d.quote = func(name string) string {
+		return "`" + strings.ReplaceAll(name, "`", "``") + "`"
+	}
+	d.columnDef = func(col *catalog.Column, enums map[string]*catalog.Enum) string {
+		var b strings.Builder
+		b.WriteString(d.quote(col.Name))
+		if enum, ok := enums[col.Type.Name]; ok && col.Type.Schema == "" {
+			var vals []string
+			for _, v := range enum.Vals {
+				vals = append(vals, literal(v))
+			}
+			fmt.Fprintf(&b, " enum(%s)", strings.Join(vals, ","))
+		} else {
+			fmt.Fprintf(&b, " %s", col.Type.Name)
+			if col.Length != nil {
+				fmt.Fprintf(&b, "(%d)", *col.Length)
+			}
+		}
+		if col.IsUnsigned {
+			b.WriteString(" unsigned")
+		}
+		if col.IsNotNull {
+			b.WriteString(" NOT NULL")
+		}
+		if col.IsAutoIncrement {
+			b.WriteString(" AUTO_INCREMENT")
+		}
+		if col.Default != "" {
+			fmt.Fprintf(&b, " DEFAULT %s", col.Default)
+		}
+		if col.Comment != "" {
+			fmt.Fprintf(&b, " COMMENT %s", literal(col.Comment))
+		}
+		return b.String()
+	}
+	d.primaryKey = func(*catalog.Column) string {
+		return " PRIMARY KEY"
+	}
+	d.dropIndex = func(qualifier string, t *catalog.Table, idx *catalog.Index) string {
+		return fmt.Sprintf("DROP INDEX %s ON %s;", d.quote(idx.Name), d.tableName(qualifier, t.Rel.Name))
+	}
+	d.tableComment = func(table, comment string) string {
+		return fmt.Sprintf("ALTER TABLE %s COMMENT %s;", table, literal(comment))
+	}
+	return d
+}
+
+var (
+	plainIdentifier  = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+	quotedIdentifier = regexp.MustCompile("^(\"(?:[^\"]|\"\")*\"|`(?:[^`]|``)*`|\\[[^\\]]*\\])$")
+)
+
+func sqliteDialect() *dialect {
+	parser := sqlite.NewParser()
+	d := &dialect{}
+	// Names are only quoted when they have to be, as the SQLite parser keeps
+	// the quotes of quoted names
+	d.quote = func(name string) string {
+		if plainIdentifier.MatchString(name) && !parser.IsReservedKeyword(name) {
+			return name
+		}
+		if quotedIdentifier.MatchString(name) {
+			return name
+		}
+		return `"` + strings.ReplaceAll(name, `"`, `""`) + `"`
+	}
+	d.columnDef = func(col *catalog.Column, _ map[string]*catalog.Enum) string {
+		var b strings.Builder
+		b.WriteString(d.quote(col.Name))
+		if col.Type.Name != "" && col.Type.Name != "any" {
+			fmt.Fprintf(&b, " %s", col.Type.Name)
+		}
+		if col.IsNotNull {
+			b.WriteString(" NOT NULL")
+		}
+		if col.Default != "" {
+			fmt.Fprintf(&b, " DEFAULT %s", col.Default)
+		}
+		return b.String()
+	}
+	d.primaryKey = func(col *catalog.Column) string {
+		if col.IsAutoIncrement {
+			return " PRIMARY KEY AUTOINCREMENT"
+		}
+		return " PRIMARY KEY"
+	}
+	d.addNeedsDefault = true
+	d.dropIndex = func(qualifier string, _ *catalog.Table, idx *catalog.Index) string {
+		return fmt.Sprintf("DROP INDEX %s;", d.tableName(qualifier, idx.Name))
+	}
+	d.rebuild = func(sd *schemaDiff, old, t *catalog.Table) []string {
+		// https://www.sqlite.org/lang_altertable.html#otheralter
+		name := t.Rel.Name
+		tmp := name + "_new"
+		var cols []string
+		for _, col := range t.Columns {
+			if findColumn(old, col.Name) != nil {
+				cols = append(cols, d.quote(col.Name))
+			}
+		}
+		list := strings.Join(cols, ", ")
+		return []string{
+			fmt.Sprintf("-- %s is rebuilt to change its columns; foreign keys and check constraints must be added to the new table by hand", d.quote(name)),
+			d.createTable(sd.to.qualifier, tmp, t, nil),
+			fmt.Sprintf("INSERT INTO %s (%s) SELECT %s FROM %s;", d.tableName(sd.to.qualifier, tmp), list, list, d.tableName(sd.from.qualifier, name)),
+			fmt.Sprintf("DROP TABLE %s;", d.tableName(sd.from.qualifier, name)),
+			fmt.Sprintf("ALTER TABLE %s RENAME TO %s;", d.tableName(sd.to.qualifier, tmp), d.quote(name)),
+		}
+	}
+	return d
+}
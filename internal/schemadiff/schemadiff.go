@@ -0,0 +1,245 @@
+// Package schemadiff compares two catalogs and returns the DDL statements that
+// turn a database with the first schema into one with the second.
+package schemadiff
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ZeyuRemtes/sqlc/internal/config"
+	"github.com/ZeyuRemtes/sqlc/internal/sql/catalog"
+)
+
+// Diff returns the statements that migrate a database built from the from
+// catalog to the to catalog. Views, and anything else the catalog doesn't
+// track, such as foreign keys and check constraints, are not compared.
+func Diff(engine config.Engine, from, to *catalog.Catalog) ([]string, error) {
+	var d *dialect
+	switch engine {
+	case config.EngineMySQL:
+		d = mysqlDialect()
+	case config.EngineSQLite:
+		d = sqliteDialect()
+	default:
+		return nil, fmt.Errorf("schemadiff: engine %s is not supported", engine)
+	}
+	var stmts []string
+	for _, s := range schemaNames(from, to) {
+		diff := &schemaDiff{
+			dialect: d,
+			from:    newSchema(engine, from, s),
+			to:      newSchema(engine, to, s),
+		}
+		schemaStmts, err := diff.statements()
+		if err != nil {
+			return nil, err
+		}
+		stmts = append(stmts, schemaStmts...)
+	}
+	return stmts, nil
+}
+
+// schema holds the tables of a catalog schema that a migration can change
+type schema struct {
+	qualifier string
+	tables    []*catalog.Table
+	byName    map[string]*catalog.Table
+	enums     map[string]*catalog.Enum
+}
+
+func newSchema(engine config.Engine, c *catalog.Catalog, name string) *schema {
+	s := &schema{
+		byName: map[string]*catalog.Table{},
+		enums:  map[string]*catalog.Enum{},
+	}
+	if name != c.DefaultSchema {
+		s.qualifier = name
+	}
+	for _, cs := range c.Schemas {
+		for _, typ := range cs.Types {
+			// Column enums are always created in the default schema
+			if enum, ok := typ.(*catalog.Enum); ok && cs.Name == c.DefaultSchema {
+				s.enums[enum.Name] = enum
+			}
+		}
+		if cs.Name != name {
+			continue
+		}
+		for _, t := range cs.Tables {
+			if t.IsView || t.IsSystem {
+				continue
+			}
+			s.tables = append(s.tables, t)
+			s.byName[t.Rel.Name] = t
+		}
+	}
+	return s
+}
+
+// schemaNames returns the names of the schemas in either catalog, skipping
+// the built-in schemas
+func schemaNames(cats ...*catalog.Catalog) []string {
+	var names []string
+	seen := map[string]bool{}
+	for _, c := range cats {
+		for _, s := range c.Schemas {
+			switch s.Name {
+			case "information_schema", "pg_catalog":
+				continue
+			}
+			if !seen[s.Name] {
+				seen[s.Name] = true
+				names = append(names, s.Name)
+			}
+		}
+	}
+	return names
+}
+
+type schemaDiff struct {
+	*dialect
+	from *schema
+	to   *schema
+}
+
+func (d *schemaDiff) statements() ([]string, error) {
+	var stmts []string
+
+	if d.addNeedsDefault {
+		for _, t := range d.to.tables {
+			old, ok := d.from.byName[t.Rel.Name]
+			if !ok {
+				continue
+			}
+			for _, col := range t.Columns {
+				if findColumn(old, col.Name) == nil && col.IsNotNull && col.Default == "" {
+					return nil, fmt.Errorf("schemadiff: column %s.%s is NOT NULL without a default, so it can't be added to existing rows", t.Rel.Name, col.Name)
+				}
+			}
+		}
+	}
+
+	// Tables with columns SQLite can't alter in place are rebuilt, which
+	// drops and recreates their indexes
+	rebuilt := map[string]bool{}
+	for _, t := range d.to.tables {
+		if old, ok := d.from.byName[t.Rel.Name]; ok && d.rebuild != nil && d.changedColumns(old, t) {
+			rebuilt[t.Rel.Name] = true
+		}
+	}
+
+	for _, old := range d.from.tables {
+		t, ok := d.to.byName[old.Rel.Name]
+		if !ok || rebuilt[old.Rel.Name] {
+			continue
+		}
+		for _, idx := range old.Indexes {
+			if !sameIndex(idx, findIndex(t, idx.Name)) {
+				stmts = append(stmts, d.dropIndex(d.from.qualifier, old, idx))
+			}
+		}
+	}
+
+	for _, old := range d.from.tables {
+		if _, ok := d.to.byName[old.Rel.Name]; !ok {
+			stmts = append(stmts, fmt.Sprintf("DROP TABLE %s;", d.tableName(d.from.qualifier, old.Rel.Name)))
+		}
+	}
+
+	for _, t := range d.to.tables {
+		old, ok := d.from.byName[t.Rel.Name]
+		switch {
+		case !ok:
+			stmts = append(stmts, d.createTable(d.to.qualifier, t.Rel.Name, t, d.to.enums))
+		case rebuilt[t.Rel.Name]:
+			stmts = append(stmts, d.rebuild(d, old, t)...)
+		default:
+			stmts = append(stmts, d.alterTable(old, t)...)
+		}
+	}
+
+	for _, t := range d.to.tables {
+		old, ok := d.from.byName[t.Rel.Name]
+		for _, idx := range t.Indexes {
+			if ok && !rebuilt[t.Rel.Name] && sameIndex(idx, findIndex(old, idx.Name)) {
+				continue
+			}
+			stmts = append(stmts, d.createIndex(d.to.qualifier, t, idx))
+		}
+	}
+	return stmts, nil
+}
+
+func (d *schemaDiff) alterTable(old, t *catalog.Table) []string {
+	var stmts []string
+	name := d.tableName(d.to.qualifier, t.Rel.Name)
+	oldKey, key := primaryKey(old), primaryKey(t)
+	keyChanged := strings.Join(oldKey, ",") != strings.Join(key, ",")
+	if keyChanged && len(oldKey) > 0 {
+		stmts = append(stmts, fmt.Sprintf("ALTER TABLE %s DROP PRIMARY KEY;", name))
+	}
+	for _, col := range old.Columns {
+		if findColumn(t, col.Name) == nil {
+			stmts = append(stmts, fmt.Sprintf("ALTER TABLE %s DROP COLUMN %s;", name, d.quote(col.Name)))
+		}
+	}
+	for _, col := range t.Columns {
+		prev := findColumn(old, col.Name)
+		switch {
+		case prev == nil:
+			stmts = append(stmts, fmt.Sprintf("ALTER TABLE %s ADD COLUMN %s;", name, d.columnDef(col, d.to.enums)))
+		case d.columnDef(prev, d.from.enums) != d.columnDef(col, d.to.enums):
+			stmts = append(stmts, fmt.Sprintf("ALTER TABLE %s MODIFY COLUMN %s;", name, d.columnDef(col, d.to.enums)))
+		}
+	}
+	if keyChanged && len(key) > 0 {
+		stmts = append(stmts, fmt.Sprintf("ALTER TABLE %s ADD PRIMARY KEY (%s);", name, d.columnList(key)))
+	}
+	if old.Comment != t.Comment && d.tableComment != nil {
+		stmts = append(stmts, d.tableComment(name, t.Comment))
+	}
+	return stmts
+}
+
+// changedColumns reports whether any column in both tables differs, or the
+// primary key changed
+func (d *schemaDiff) changedColumns(old, t *catalog.Table) bool {
+	if strings.Join(primaryKey(old), ",") != strings.Join(primaryKey(t), ",") {
+		return true
+	}
+	for _, col := range t.Columns {
+		if prev := findColumn(old, col.Name); prev != nil && !d.sameColumn(prev, col) {
+			return true
+		}
+	}
+	return false
+}
+
+func (d *schemaDiff) sameColumn(a, b *catalog.Column) bool {
+	return d.columnDef(a, d.from.enums) == d.columnDef(b, d.to.enums) && a.IsPrimaryKey == b.IsPrimaryKey
+}
+
+func findColumn(t *catalog.Table, name string) *catalog.Column {
+	for _, col := range t.Columns {
+		if col.Name == name {
+			return col
+		}
+	}
+	return nil
+}
+
+func findIndex(t *catalog.Table, name string) *catalog.Index {
+	for _, idx := range t.Indexes {
+		if idx.Name == name {
+			return idx
+		}
+	}
+	return nil
+}
+
+func sameIndex(a, b *catalog.Index) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return a.Unique == b.Unique && strings.Join(a.Columns, ",") == strings.Join(b.Columns, ",")
+}
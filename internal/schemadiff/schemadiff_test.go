@@ -0,0 +1,158 @@
+package schemadiff
+
+import (
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/ZeyuRemtes/sqlc/internal/config"
+	"github.com/ZeyuRemtes/sqlc/internal/engine/dolphin"
+	"github.com/ZeyuRemtes/sqlc/internal/engine/sqlite"
+	"github.com/ZeyuRemtes/sqlc/internal/sql/ast"
+	"github.com/ZeyuRemtes/sqlc/internal/sql/catalog"
+)
+
+func parseCatalog(t *testing.T, engine config.Engine, schema string) *catalog.Catalog {
+	t.Helper()
+	var c *catalog.Catalog
+	var parser interface {
+		Parse(io.Reader) ([]ast.Statement, error)
+	}
+	switch engine {
+	case config.EngineMySQL:
+		c, parser = dolphin.NewCatalog(), dolphin.NewParser()
+	case config.EngineSQLite:
+		c, parser = sqlite.NewCatalog(), sqlite.NewParser()
+	}
+	stmts, err := parser.Parse(strings.NewReader(schema))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, stmt := range stmts {
+		if err := c.Update(stmt, nil); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return c
+}
+
+func TestDiff(t *testing.T) {
+	for _, test := range []struct {
+		name   string
+		engine config.Engine
+		from   string
+		to     string
+		want   []string
+	}{
+		{
+			"mysql columns",
+			config.EngineMySQL,
+			"CREATE TABLE authors (id bigint NOT NULL, name text, age int);",
+			"CREATE TABLE authors (id bigint NOT NULL, name varchar(255) NOT NULL, bio text COMMENT 'about');",
+			[]string{
+				"ALTER TABLE `authors` DROP COLUMN `age`;",
+				"ALTER TABLE `authors` MODIFY COLUMN `name` varchar(255) NOT NULL;",
+				"ALTER TABLE `authors` ADD COLUMN `bio` text COMMENT 'about';",
+			},
+		},
+		{
+			"mysql enums",
+			config.EngineMySQL,
+			"CREATE TABLE authors (status enum('a', 'b'));",
+			"CREATE TABLE authors (status enum('a', 'b', 'c') NOT NULL);",
+			[]string{
+				"ALTER TABLE `authors` MODIFY COLUMN `status` enum('a','b','c') NOT NULL;",
+			},
+		},
+		{
+			"mysql tables and indexes",
+			config.EngineMySQL,
+			"CREATE TABLE authors (id bigint, name text, KEY name_idx (name)); CREATE TABLE old (id int);",
+			"CREATE TABLE authors (id bigint, name text, UNIQUE KEY name_idx (name)); CREATE TABLE books (id int, INDEX (id));",
+			[]string{
+				"DROP INDEX `name_idx` ON `authors`;",
+				"DROP TABLE `old`;",
+				"CREATE TABLE `books` (\n  `id` int\n);",
+				"CREATE UNIQUE INDEX `name_idx` ON `authors` (`name`);",
+				"CREATE INDEX `id` ON `books` (`id`);",
+			},
+		},
+		{
+			"mysql keys and defaults",
+			config.EngineMySQL,
+			"CREATE TABLE authors (id bigint NOT NULL, code int NOT NULL, PRIMARY KEY (id), score int DEFAULT 0);",
+			"CREATE TABLE authors (id bigint NOT NULL, code int NOT NULL, PRIMARY KEY (id, code), score int DEFAULT 1); CREATE TABLE books (id bigint NOT NULL AUTO_INCREMENT PRIMARY KEY, title varchar(255) NOT NULL DEFAULT 'untitled');",
+			[]string{
+				"ALTER TABLE `authors` DROP PRIMARY KEY;",
+				"ALTER TABLE `authors` MODIFY COLUMN `score` int DEFAULT 1;",
+				"ALTER TABLE `authors` ADD PRIMARY KEY (`id`, `code`);",
+				"CREATE TABLE `books` (\n  `id` bigint NOT NULL AUTO_INCREMENT PRIMARY KEY,\n  `title` varchar(255) NOT NULL DEFAULT 'untitled'\n);",
+			},
+		},
+		{
+			"sqlite keys and defaults",
+			config.EngineSQLite,
+			"CREATE TABLE authors (id integer NOT NULL);",
+			"CREATE TABLE authors (id integer NOT NULL, score int NOT NULL DEFAULT 0); CREATE TABLE books (id integer PRIMARY KEY AUTOINCREMENT, created text DEFAULT (datetime('now'))); CREATE TABLE tags (book_id integer, name text, PRIMARY KEY (book_id, name));",
+			[]string{
+				"ALTER TABLE authors ADD COLUMN score int NOT NULL DEFAULT 0;",
+				"CREATE TABLE books (\n  id integer NOT NULL PRIMARY KEY AUTOINCREMENT,\n  created text DEFAULT (datetime('now'))\n);",
+				"CREATE TABLE tags (\n  book_id integer,\n  name text,\n  PRIMARY KEY (book_id, name)\n);",
+			},
+		},
+		{
+			"sqlite add column",
+			config.EngineSQLite,
+			"CREATE TABLE authors (id integer NOT NULL);",
+			`CREATE TABLE authors (id integer NOT NULL, "order" text); CREATE INDEX authors_order ON authors ("order");`,
+			[]string{
+				`ALTER TABLE authors ADD COLUMN "order" text;`,
+				`CREATE INDEX authors_order ON authors ("order");`,
+			},
+		},
+		{
+			"sqlite rebuild",
+			config.EngineSQLite,
+			"CREATE TABLE authors (id integer NOT NULL, name text, age int); CREATE INDEX authors_name ON authors (name);",
+			"CREATE TABLE authors (id integer NOT NULL, name text NOT NULL); CREATE INDEX authors_name ON authors (name);",
+			[]string{
+				"-- authors is rebuilt to change its columns; foreign keys and check constraints must be added to the new table by hand",
+				"CREATE TABLE authors_new (\n  id integer NOT NULL,\n  name text NOT NULL\n);",
+				"INSERT INTO authors_new (id, name) SELECT id, name FROM authors;",
+				"DROP TABLE authors;",
+				"ALTER TABLE authors_new RENAME TO authors;",
+				"CREATE INDEX authors_name ON authors (name);",
+			},
+		},
+		{
+			"sqlite unchanged",
+			config.EngineSQLite,
+			"CREATE TABLE authors (id integer, name text); CREATE UNIQUE INDEX authors_name ON authors (name);",
+			"CREATE TABLE authors (id integer, name text); CREATE UNIQUE INDEX authors_name ON authors (name);",
+			nil,
+		},
+	} {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			from := parseCatalog(t, test.engine, test.from)
+			to := parseCatalog(t, test.engine, test.to)
+			got, err := Diff(test.engine, from, to)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(test.want, got); diff != "" {
+				t.Errorf("statements mismatch:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDiffSQLiteNotNull(t *testing.T) {
+	from := parseCatalog(t, config.EngineSQLite, "CREATE TABLE authors (id integer NOT NULL);")
+	to := parseCatalog(t, config.EngineSQLite, "CREATE TABLE authors (id integer NOT NULL, name text NOT NULL);")
+	if _, err := Diff(config.EngineSQLite, from, to); err == nil {
+		t.Errorf("expected an error for a NOT NULL column without a default")
+	}
+}
@@ -104,6 +104,10 @@ func StripComments(sql string) (string, []string, error) {
 			comments = append(comments, t)
 			continue
 		}
+		if len(lines) == 0 && strings.TrimSpace(t) == "" {
+			// Such as the lines left by the variants of other engines
+			continue
+		}
 		lines = append(lines, t)
 	}
 	return strings.Join(lines, "\n"), comments, s.Err()
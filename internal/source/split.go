@@ -0,0 +1,140 @@
+package source
+
+import (
+	"strings"
+	"unicode"
+)
+
+// Span is a half-open byte range [Start, End) into a source file.
+type Span struct {
+	Start int
+	End   int
+}
+
+// Split divides SQL source into statements on top-level semicolons. Quoted
+// strings, quoted identifiers, comments and BEGIN ... END bodies of CREATE
+// TRIGGER / FUNCTION / PROCEDURE statements are never split. Each span
+// includes the comments leading up to the statement and the terminating
+// semicolon. Spans holding only whitespace and comments are omitted.
+//
+// If mysql is true, MySQL lexical rules apply: '#' starts a line comment and
+// a backslash escapes the next character inside a string.
+func Split(src string, mysql bool) []Span {
+	var spans []Span
+	start := 0
+	depth := 0
+	hasCode := false
+	var words []string
+
+	emit := func(end int) {
+		if hasCode {
+			spans = append(spans, Span{Start: start, End: end})
+		}
+		start = end
+		depth = 0
+		hasCode = false
+		words = words[:0]
+	}
+
+	for i := 0; i < len(src); i++ {
+		ch := src[i]
+		switch {
+		case ch == '-' && i+1 < len(src) && src[i+1] == '-', mysql && ch == '#':
+			for i < len(src) && src[i] != '\n' {
+				i++
+			}
+
+		case ch == '/' && i+1 < len(src) && src[i+1] == '*':
+			end := strings.Index(src[i+2:], "*/")
+			if end < 0 {
+				i = len(src)
+			} else {
+				i += end + 3
+			}
+
+		case ch == '\'' || ch == '"' || ch == '`':
+			hasCode = true
+			for i++; i < len(src); i++ {
+				if mysql && src[i] == '\\' && ch == '\'' {
+					i++
+					continue
+				}
+				if src[i] == ch {
+					// A doubled quote is an escaped quote
+					if i+1 < len(src) && src[i+1] == ch {
+						i++
+						continue
+					}
+					break
+				}
+			}
+
+		case ch == ';':
+			if depth == 0 {
+				emit(i + 1)
+			}
+
+		case isWordByte(ch):
+			hasCode = true
+			j := i
+			for j < len(src) && isWordByte(src[j]) {
+				j++
+			}
+			word := strings.ToUpper(src[i:j])
+			i = j - 1
+			if len(words) < 8 {
+				words = append(words, word)
+			}
+			if !hasBody(words) {
+				continue
+			}
+			switch word {
+			case "BEGIN", "CASE":
+				depth++
+			case "END":
+				if depth > 0 {
+					depth--
+				}
+			}
+
+		case !unicode.IsSpace(rune(ch)):
+			hasCode = true
+		}
+	}
+	emit(len(src))
+	return spans
+}
+
+// hasBody reports whether a statement starting with the given words can
+// contain a BEGIN ... END block with nested semicolons.
+func hasBody(words []string) bool {
+	if len(words) == 0 || words[0] != "CREATE" {
+		return false
+	}
+	for _, w := range words[1:] {
+		switch w {
+		case "TRIGGER", "FUNCTION", "PROCEDURE":
+			return true
+		}
+	}
+	return false
+}
+
+func isWordByte(ch byte) bool {
+	return ch == '_' || ch >= 'a' && ch <= 'z' || ch >= 'A' && ch <= 'Z' || ch >= '0' && ch <= '9'
+}
+
+// Blank replaces every character except newlines with a space, keeping byte
+// offsets and line numbers of whatever follows it intact.
+func Blank(s string) string {
+	var b strings.Builder
+	b.Grow(len(s))
+	for i := 0; i < len(s); i++ {
+		if s[i] == '\n' {
+			b.WriteByte('\n')
+		} else {
+			b.WriteByte(' ')
+		}
+	}
+	return b.String()
+}
@@ -0,0 +1,89 @@
+package source
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestSplit(t *testing.T) {
+	for _, tc := range []struct {
+		name  string
+		src   string
+		mysql bool
+		want  []string
+	}{
+		{
+			name: "simple",
+			src:  "SELECT 1;\nSELECT 2;",
+			want: []string{"SELECT 1;", "\nSELECT 2;"},
+		},
+		{
+			name: "missing trailing semicolon",
+			src:  "SELECT 1;\nSELECT 2\n",
+			want: []string{"SELECT 1;", "\nSELECT 2\n"},
+		},
+		{
+			name: "comments only",
+			src:  "SELECT 1;\n-- trailing; comment\n/* block; */\n",
+			want: []string{"SELECT 1;"},
+		},
+		{
+			name: "quoted semicolons",
+			src:  `SELECT ';', "a;b", ` + "`c;d`" + `;SELECT 'it''s;';`,
+			want: []string{`SELECT ';', "a;b", ` + "`c;d`" + `;`, `SELECT 'it''s;';`},
+		},
+		{
+			name:  "mysql hash comment and backslash escape",
+			src:   "# comment;\nSELECT 'a\\';b';\nSELECT 2;",
+			mysql: true,
+			want:  []string{"# comment;\nSELECT 'a\\';b';", "\nSELECT 2;"},
+		},
+		{
+			name: "sqlite backslash is literal",
+			src:  "SELECT '\\';SELECT 2;",
+			want: []string{"SELECT '\\';", "SELECT 2;"},
+		},
+		{
+			name: "trigger body",
+			src: "CREATE TRIGGER t AFTER INSERT ON a BEGIN\n" +
+				"  UPDATE b SET c = CASE WHEN 1 THEN 2 ELSE 3 END;\n" +
+				"  DELETE FROM d;\n" +
+				"END;\nSELECT 1;",
+			want: []string{
+				"CREATE TRIGGER t AFTER INSERT ON a BEGIN\n" +
+					"  UPDATE b SET c = CASE WHEN 1 THEN 2 ELSE 3 END;\n" +
+					"  DELETE FROM d;\n" +
+					"END;",
+				"\nSELECT 1;",
+			},
+		},
+		{
+			name: "transaction begin is not a body",
+			src:  "BEGIN;\nSELECT 1;\nEND;",
+			want: []string{"BEGIN;", "\nSELECT 1;", "\nEND;"},
+		},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			var got []string
+			for _, span := range Split(tc.src, tc.mysql) {
+				got = append(got, tc.src[span.Start:span.End])
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("split mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestBlank(t *testing.T) {
+	in := "SELECT 1;\n-- x\nSELECT 2;"
+	out := Blank(in)
+	if len(out) != len(in) {
+		t.Fatalf("length changed: %d != %d", len(out), len(in))
+	}
+	if want := "         \n    \n         "; out != want {
+		t.Errorf("got %q, want %q", out, want)
+	}
+}
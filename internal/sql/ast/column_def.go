@@ -1,13 +1,18 @@
 package ast
 
 type ColumnDef struct {
-	Colname    string
-	TypeName   *TypeName
-	IsNotNull  bool
-	IsUnsigned bool
-	IsArray    bool
-	Vals       *List
-	Length     *int
+	Colname      string
+	TypeName     *TypeName
+	IsNotNull    bool
+	IsUnsigned   bool
+	IsArray      bool
+	IsPrimaryKey bool
+	Vals         *List
+	Length       *int
+
+	IsAutoIncrement bool
+	// The default value as written in the schema
+	Default string
 
 	// From pg.ColumnDef
 	Inhcount      int
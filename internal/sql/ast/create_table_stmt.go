@@ -7,6 +7,7 @@ type CreateTableStmt struct {
 	ReferTable  *TableName
 	Comment     string
 	Inherits    []*TableName
+	Indexes     []*IndexStmt
 }
 
 func (n *CreateTableStmt) Pos() int {
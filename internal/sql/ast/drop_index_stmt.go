@@ -0,0 +1,13 @@
+package ast
+
+type DropIndexStmt struct {
+	IfExists bool
+	Schema   string
+	Name     string
+	// The table the index is defined on, if the statement names it
+	Table *TableName
+}
+
+func (n *DropIndexStmt) Pos() int {
+	return 0
+}
@@ -173,6 +173,9 @@ func (a *application) apply(parent ast.Node, name string, iter *iterator, n ast.
 	case *ast.DropFunctionStmt:
 		// pass
 
+	case *ast.DropIndexStmt:
+		// pass
+
 	case *ast.DropSchemaStmt:
 		// pass
 
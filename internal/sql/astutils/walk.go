@@ -95,6 +95,9 @@ func Walk(f Visitor, node ast.Node) {
 	case *ast.DropFunctionStmt:
 		// pass
 
+	case *ast.DropIndexStmt:
+		// pass
+
 	case *ast.DropSchemaStmt:
 		// pass
 
@@ -95,17 +95,23 @@ func (c *Catalog) Update(stmt ast.Statement, colGen columnGenerator) error {
 		err = c.createSchema(n)
 
 	case *ast.CreateTableStmt:
-		err = c.createTable(n)
+		err = c.createTable(n, stmt.Raw.StmtLocation)
 
 	case *ast.CreateTableAsStmt:
 		err = c.createTableAs(n, colGen)
 
+	case *ast.IndexStmt:
+		err = c.createIndex(n, stmt.Raw.StmtLocation)
+
 	case *ast.ViewStmt:
 		err = c.createView(n, colGen)
 
 	case *ast.DropFunctionStmt:
 		err = c.dropFunction(n)
 
+	case *ast.DropIndexStmt:
+		err = c.dropIndex(n, stmt.Raw.StmtLocation)
+
 	case *ast.DropSchemaStmt:
 		err = c.dropSchema(n)
 
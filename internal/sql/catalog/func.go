@@ -82,6 +82,29 @@ func (c *Catalog) createFunction(stmt *ast.CreateFunctionStmt) error {
 	return nil
 }
 
+// AddFunction adds fn to the schema named ns, or the default schema if ns is
+// empty. An existing function with the same signature is replaced.
+func (c *Catalog) AddFunction(ns string, fn *Function) error {
+	if ns == "" {
+		ns = c.DefaultSchema
+	}
+	s, err := c.getSchema(ns)
+	if err != nil {
+		return err
+	}
+	types := make([]*ast.TypeName, len(fn.Args))
+	for i, arg := range fn.Args {
+		types[i] = arg.Type
+	}
+	_, idx, err := s.getFunc(&ast.FuncName{Name: fn.Name}, types)
+	if err == nil {
+		s.Funcs[idx] = fn
+	} else {
+		s.Funcs = append(s.Funcs, fn)
+	}
+	return nil
+}
+
 func (c *Catalog) dropFunction(stmt *ast.DropFunctionStmt) error {
 	for _, spec := range stmt.Funcs {
 		ns := spec.Name.Schema
@@ -0,0 +1,162 @@
+package catalog
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/ZeyuRemtes/sqlc/internal/sql/ast"
+	"github.com/ZeyuRemtes/sqlc/internal/sql/sqlerr"
+)
+
+// Index describes an index on the columns of a table
+type Index struct {
+	Name    string
+	Columns []string
+	Unique  bool
+}
+
+func (s *Schema) getIndex(name string) (*Table, int, error) {
+	for _, t := range s.Tables {
+		if i := t.indexOf(name); i >= 0 {
+			return t, i, nil
+		}
+	}
+	return nil, -1, sqlerr.IndexNotFound(name)
+}
+
+func (table *Table) indexOf(name string) int {
+	for i := range table.Indexes {
+		if table.Indexes[i].Name == name {
+			return i
+		}
+	}
+	return -1
+}
+
+// createIndex adds the index of a CREATE INDEX statement at loc to its table
+func (c *Catalog) createIndex(stmt *ast.IndexStmt, loc int) error {
+	if stmt.Relation == nil || stmt.Relation.Relname == nil {
+		return nil
+	}
+	rel := &ast.TableName{Name: *stmt.Relation.Relname}
+	if stmt.Relation.Schemaname != nil {
+		rel.Schema = *stmt.Relation.Schemaname
+	}
+	_, table, err := c.getTable(rel)
+	if err != nil {
+		return located(err, loc)
+	}
+	return addIndex(table, stmt, loc)
+}
+
+func addIndex(table *Table, stmt *ast.IndexStmt, loc int) error {
+	idx := &Index{Unique: stmt.Unique}
+	if stmt.IndexParams != nil {
+		for _, item := range stmt.IndexParams.Items {
+			if elem, ok := item.(*ast.IndexElem); ok && elem.Name != nil {
+				idx.Columns = append(idx.Columns, *elem.Name)
+			}
+		}
+	}
+	if stmt.Idxname == nil {
+		if len(idx.Columns) > 0 {
+			idx.Name = unnamedIndex(table, idx.Columns[0])
+		}
+		table.Indexes = append(table.Indexes, idx)
+		return nil
+	}
+	idx.Name = *stmt.Idxname
+	// Index names only have to be unique per table in MySQL
+	if table.indexOf(idx.Name) >= 0 {
+		if stmt.IfNotExists {
+			return nil
+		}
+		return located(sqlerr.IndexExists(idx.Name), loc)
+	}
+	table.Indexes = append(table.Indexes, idx)
+	return nil
+}
+
+// unnamedIndex names an index without a name the way MySQL does: after its
+// first column, followed by _2, _3 and so on when the name is taken
+func unnamedIndex(table *Table, column string) string {
+	name := column
+	for n := 2; table.indexOf(name) >= 0; n++ {
+		name = fmt.Sprintf("%s_%d", column, n)
+	}
+	return name
+}
+
+// dropIndex removes the index of a DROP INDEX statement at loc. An index named
+// with its table, as MySQL does, is looked up on that table; otherwise index
+// names are unique in their schema, as in SQLite.
+func (c *Catalog) dropIndex(stmt *ast.DropIndexStmt, loc int) error {
+	var table *Table
+	i := -1
+	var err error
+	if stmt.Table != nil {
+		_, table, err = c.getTable(stmt.Table)
+		if err == nil {
+			if i = table.indexOf(stmt.Name); i < 0 {
+				err = sqlerr.IndexNotFound(stmt.Name)
+			}
+		}
+	} else {
+		ns := stmt.Schema
+		if ns == "" {
+			ns = c.DefaultSchema
+		}
+		var schema *Schema
+		schema, err = c.getSchema(ns)
+		if err == nil {
+			table, i, err = schema.getIndex(stmt.Name)
+		}
+	}
+	if errors.Is(err, sqlerr.NotFound) && stmt.IfExists {
+		return nil
+	} else if err != nil {
+		return located(err, loc)
+	}
+	table.Indexes = append(table.Indexes[:i], table.Indexes[i+1:]...)
+	return nil
+}
+
+// located reports an error of an index statement at loc, as index statements
+// don't carry a position of their own
+func located(err error, loc int) error {
+	var serr *sqlerr.Error
+	if errors.As(err, &serr) && serr.Location == 0 {
+		serr.Location = loc
+	}
+	return err
+}
+
+// renameIndexColumn updates the indexes of a table after a column is renamed
+func (table *Table) renameIndexColumn(from, to string) {
+	for _, idx := range table.Indexes {
+		for i := range idx.Columns {
+			if idx.Columns[i] == from {
+				idx.Columns[i] = to
+			}
+		}
+	}
+}
+
+// dropIndexColumn removes a dropped column from the indexes of a table,
+// dropping any index left without columns
+func (table *Table) dropIndexColumn(name string) {
+	var indexes []*Index
+	for _, idx := range table.Indexes {
+		var cols []string
+		for _, col := range idx.Columns {
+			if col != name {
+				cols = append(cols, col)
+			}
+		}
+		idx.Columns = cols
+		if len(cols) > 0 {
+			indexes = append(indexes, idx)
+		}
+	}
+	table.Indexes = indexes
+}
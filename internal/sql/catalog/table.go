@@ -13,9 +13,12 @@ import (
 // A database table is a collection of related data held in a table format within a database.
 // It consists of columns and rows.
 type Table struct {
-	Rel     *ast.TableName
-	Columns []*Column
-	Comment string
+	Rel      *ast.TableName
+	Columns  []*Column
+	Comment  string
+	Indexes  []*Index
+	IsView   bool
+	IsSystem bool // built into the database engine
 }
 
 func (table *Table) isExistColumn(cmd *ast.AlterTableCmd) (int, error) {
@@ -42,12 +45,16 @@ func (table *Table) addColumn(cmd *ast.AlterTableCmd) error {
 	}
 
 	table.Columns = append(table.Columns, &Column{
-		Name:       cmd.Def.Colname,
-		Type:       *cmd.Def.TypeName,
-		IsNotNull:  cmd.Def.IsNotNull,
-		IsUnsigned: cmd.Def.IsUnsigned,
-		IsArray:    cmd.Def.IsArray,
-		Length:     cmd.Def.Length,
+		Name:            cmd.Def.Colname,
+		Type:            *cmd.Def.TypeName,
+		IsNotNull:       cmd.Def.IsNotNull,
+		IsUnsigned:      cmd.Def.IsUnsigned,
+		IsArray:         cmd.Def.IsArray,
+		Comment:         cmd.Def.Comment,
+		Length:          cmd.Def.Length,
+		IsPrimaryKey:    cmd.Def.IsPrimaryKey,
+		IsAutoIncrement: cmd.Def.IsAutoIncrement,
+		Default:         cmd.Def.Default,
 	})
 	return nil
 }
@@ -71,6 +78,7 @@ func (table *Table) dropColumn(cmd *ast.AlterTableCmd) error {
 	}
 	if index >= 0 {
 		table.Columns = append(table.Columns[:index], table.Columns[index+1:]...)
+		table.dropIndexColumn(*cmd.Name)
 	}
 	return nil
 }
@@ -101,13 +109,18 @@ func (table *Table) setNotNull(cmd *ast.AlterTableCmd) error {
 //
 // TODO: Should this just be ast Nodes?
 type Column struct {
-	Name       string
-	Type       ast.TypeName
-	IsNotNull  bool
-	IsUnsigned bool
-	IsArray    bool
-	Comment    string
-	Length     *int
+	Name         string
+	Type         ast.TypeName
+	IsNotNull    bool
+	IsUnsigned   bool
+	IsArray      bool
+	IsPrimaryKey bool
+	Comment      string
+	Length       *int
+
+	IsAutoIncrement bool
+	// The default value as written in the schema
+	Default string
 }
 
 // An interface is used to resolve a circular import between the catalog and compiler packages.
@@ -174,6 +187,13 @@ func (c *Catalog) alterTable(stmt *ast.AlterTableStmt) error {
 		case *ast.AlterTableCmd:
 			switch cmd.Subtype {
 			case ast.AT_AddColumn:
+				if cmd.Def.Vals != nil {
+					typeName, err := c.columnEnum(table.Rel.Name, cmd.Def.Colname, cmd.Def.Vals)
+					if err != nil {
+						return err
+					}
+					cmd.Def.TypeName = &typeName
+				}
 				if err := table.addColumn(cmd); err != nil {
 					return err
 				}
@@ -225,7 +245,8 @@ func (c *Catalog) alterTableSetSchema(stmt *ast.AlterTableSetSchemaStmt) error {
 	return nil
 }
 
-func (c *Catalog) createTable(stmt *ast.CreateTableStmt) error {
+// createTable adds the table of a CREATE TABLE statement at loc
+func (c *Catalog) createTable(stmt *ast.CreateTableStmt, loc int) error {
 	ns := stmt.Name.Schema
 	if ns == "" {
 		ns = c.DefaultSchema
@@ -293,20 +314,20 @@ func (c *Catalog) createTable(stmt *ast.CreateTableStmt) error {
 			}
 
 			tc := &Column{
-				Name:       col.Colname,
-				Type:       *col.TypeName,
-				IsNotNull:  col.IsNotNull,
-				IsUnsigned: col.IsUnsigned,
-				IsArray:    col.IsArray,
-				Comment:    col.Comment,
-				Length:     col.Length,
+				Name:            col.Colname,
+				Type:            *col.TypeName,
+				IsNotNull:       col.IsNotNull,
+				IsUnsigned:      col.IsUnsigned,
+				IsArray:         col.IsArray,
+				Comment:         col.Comment,
+				Length:          col.Length,
+				IsPrimaryKey:    col.IsPrimaryKey,
+				IsAutoIncrement: col.IsAutoIncrement,
+				Default:         col.Default,
 			}
 			if col.Vals != nil {
-				typeName := ast.TypeName{
-					Name: fmt.Sprintf("%s_%s", stmt.Name.Name, col.Colname),
-				}
-				s := &ast.CreateEnumStmt{TypeName: &typeName, Vals: col.Vals}
-				if err := c.createEnum(s); err != nil {
+				typeName, err := c.columnEnum(stmt.Name.Name, col.Colname, col.Vals)
+				if err != nil {
 					return err
 				}
 				tc.Type = typeName
@@ -322,6 +343,12 @@ func (c *Catalog) createTable(stmt *ast.CreateTableStmt) error {
 		}
 	}
 
+	for _, idx := range stmt.Indexes {
+		if err := addIndex(&tbl, idx, loc); err != nil {
+			return err
+		}
+	}
+
 	schema.Tables = append(schema.Tables, &tbl)
 	return nil
 }
@@ -368,6 +395,7 @@ func (c *Catalog) renameColumn(stmt *ast.RenameColumnStmt) error {
 	if idx == -1 {
 		return sqlerr.ColumnNotFound(tbl.Rel.Name, stmt.Col.Name)
 	}
+	tbl.renameIndexColumn(tbl.Columns[idx].Name, *stmt.NewName)
 	tbl.Columns[idx].Name = *stmt.NewName
 	return nil
 }
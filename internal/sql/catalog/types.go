@@ -90,6 +90,26 @@ func (c *Catalog) createEnum(stmt *ast.CreateEnumStmt) error {
 	return nil
 }
 
+// columnEnum returns the enum type of a column declared with a list of
+// values, as in MySQL. A column of the same table and name that was dropped
+// and added again replaces the values of the existing type.
+func (c *Catalog) columnEnum(table, column string, vals *ast.List) (ast.TypeName, error) {
+	typeName := ast.TypeName{
+		Name: fmt.Sprintf("%s_%s", table, column),
+	}
+	schema, err := c.getSchema(c.DefaultSchema)
+	if err != nil {
+		return typeName, err
+	}
+	if typ, _, err := schema.getType(&typeName); err == nil {
+		if enum, ok := typ.(*Enum); ok {
+			enum.Vals = stringSlice(vals)
+			return typeName, nil
+		}
+	}
+	return typeName, c.createEnum(&ast.CreateEnumStmt{TypeName: &typeName, Vals: vals})
+}
+
 func stringSlice(list *ast.List) []string {
 	items := []string{}
 	for _, item := range list.Items {
@@ -7,15 +7,19 @@ import (
 	"github.com/ZeyuRemtes/sqlc/internal/sql/astutils"
 )
 
-// Embed is an instance of `sqlc.embed(param)`
+// Embed is an instance of `sqlc.embed(param)` or `sqlc.embed_many(param)`
 type Embed struct {
 	Table *ast.TableName
+	Many  bool
 	param string
 	Node  *ast.ColumnRef
 }
 
 // Orig string to replace
 func (e Embed) Orig() string {
+	if e.Many {
+		return fmt.Sprintf("sqlc.embed_many(%s)", e.param)
+	}
 	return fmt.Sprintf("sqlc.embed(%s)", e.param)
 }
 
@@ -32,7 +36,8 @@ func (es EmbedSet) Find(node *ast.ColumnRef) (*Embed, bool) {
 	return nil, false
 }
 
-// Embeds rewrites `sqlc.embed(param)` to a `ast.ColumnRef` of form `param.*`.
+// Embeds rewrites `sqlc.embed(param)` and `sqlc.embed_many(param)` to a
+// `ast.ColumnRef` of form `param.*`.
 // The compiler can make use of the returned `EmbedSet` while expanding the
 // `param.*` column refs to produce the correct source edits.
 func Embeds(raw *ast.RawStmt) (*ast.RawStmt, EmbedSet) {
@@ -62,6 +67,7 @@ func Embeds(raw *ast.RawStmt) (*ast.RawStmt, EmbedSet) {
 
 			embeds = append(embeds, &Embed{
 				Table: &ast.TableName{Name: param},
+				Many:  fun.Func.Name == "embed_many",
 				param: param,
 				Node:  node,
 			})
@@ -86,6 +92,6 @@ func isEmbed(node ast.Node) bool {
 		return false
 	}
 
-	isValid := call.Func.Schema == "sqlc" && call.Func.Name == "embed"
+	isValid := call.Func.Schema == "sqlc" && (call.Func.Name == "embed" || call.Func.Name == "embed_many")
 	return isValid
 }
@@ -0,0 +1,48 @@
+package rewrite
+
+import (
+	"github.com/ZeyuRemtes/sqlc/internal/sql/ast"
+	"github.com/ZeyuRemtes/sqlc/internal/sql/astutils"
+)
+
+// Optional is an instance of `sqlc.optional(predicate)`
+type Optional struct {
+	// Location of the `sqlc.optional` call in the source
+	Location int
+	Node     ast.Node
+}
+
+// Optionals rewrites `sqlc.optional(predicate)` to the predicate itself, so
+// the compiler type-checks the query as if every predicate was present.
+// The returned locations are used to mark the predicates in the query text.
+func Optionals(raw *ast.RawStmt) (*ast.RawStmt, []*Optional) {
+	var optionals []*Optional
+
+	node := astutils.Apply(raw, func(cr *astutils.Cursor) bool {
+		node := cr.Node()
+		if !IsOptional(node) {
+			return true
+		}
+		fun := node.(*ast.FuncCall)
+		if len(fun.Args.Items) != 1 {
+			return false
+		}
+		pred := fun.Args.Items[0]
+		optionals = append(optionals, &Optional{
+			Location: fun.Location,
+			Node:     pred,
+		})
+		cr.Replace(pred)
+		return false
+	}, nil)
+
+	return node.(*ast.RawStmt), optionals
+}
+
+func IsOptional(node ast.Node) bool {
+	call, ok := node.(*ast.FuncCall)
+	if !ok || call.Func == nil {
+		return false
+	}
+	return call.Func.Schema == "sqlc" && call.Func.Name == "optional"
+}
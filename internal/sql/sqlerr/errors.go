@@ -47,6 +47,22 @@ func ColumnNotFound(rel, col string) *Error {
 	}
 }
 
+func IndexExists(idx string) *Error {
+	return &Error{
+		Err:     Exists,
+		Code:    "42P07",
+		Message: fmt.Sprintf("index \"%s\"", idx),
+	}
+}
+
+func IndexNotFound(idx string) *Error {
+	return &Error{
+		Err:     NotFound,
+		Code:    "42704",
+		Message: fmt.Sprintf("index \"%s\"", idx),
+	}
+}
+
 func RelationExists(rel string) *Error {
 	return &Error{
 		Err:     Exists,
@@ -44,6 +44,37 @@ func validateCopyfrom(n ast.Node) error {
 	return nil
 }
 
+func validatePatch(n ast.Node, name string) error {
+	stmt, ok := n.(*ast.UpdateStmt)
+	if !ok {
+		return fmt.Errorf("query %q: :patch requires an UPDATE statement", name)
+	}
+	for _, item := range stmt.TargetList.Items {
+		if res, ok := item.(*ast.ResTarget); ok {
+			if _, ok := res.Val.(*ast.ParamRef); ok {
+				return nil
+			}
+		}
+	}
+	return fmt.Errorf("query %q: :patch requires a column set to a parameter", name)
+}
+
+func validatePaginate(n ast.Node, name string) error {
+	stmt, ok := n.(*ast.SelectStmt)
+	if !ok {
+		return fmt.Errorf("query %q: :paginate requires a SELECT statement", name)
+	}
+	switch {
+	case stmt.Op != ast.None:
+		return fmt.Errorf("query %q: :paginate doesn't support UNION, INTERSECT or EXCEPT", name)
+	case stmt.LimitCount != nil || stmt.LimitOffset != nil:
+		return fmt.Errorf("query %q: :paginate adds its own LIMIT, remove LIMIT and OFFSET", name)
+	case stmt.GroupClause != nil && len(stmt.GroupClause.Items) > 0, stmt.HavingClause != nil:
+		return fmt.Errorf("query %q: :paginate doesn't support GROUP BY or HAVING", name)
+	}
+	return nil
+}
+
 func validateBatch(n ast.Node) error {
 	nums, _, _ := ParamRef(n)
 	if len(nums) == 0 {
@@ -56,10 +87,16 @@ func Cmd(n ast.Node, name, cmd string) error {
 	if cmd == metadata.CmdCopyFrom {
 		return validateCopyfrom(n)
 	}
+	if cmd == metadata.CmdPatch {
+		return validatePatch(n, name)
+	}
+	if cmd == metadata.CmdPaginate {
+		return validatePaginate(n, name)
+	}
 	if (cmd == metadata.CmdBatchExec || cmd == metadata.CmdBatchMany) || cmd == metadata.CmdBatchOne {
 		return validateBatch(n)
 	}
-	if !(cmd == metadata.CmdMany || cmd == metadata.CmdOne) {
+	if !(cmd == metadata.CmdMany || cmd == metadata.CmdIter || cmd == metadata.CmdMap || cmd == metadata.CmdOne || cmd == metadata.CmdOpt) {
 		return nil
 	}
 	var list *ast.List
@@ -34,29 +34,46 @@ func (v *funcCallVisitor) Visit(node ast.Node) astutils.Visitor {
 	// Custom validation for sqlc.arg, sqlc.narg and sqlc.slice
 	// TODO: Replace this once type-checking is implemented
 	if fn.Schema == "sqlc" {
-		if !(fn.Name == "arg" || fn.Name == "narg" || fn.Name == "slice" || fn.Name == "embed") {
+		if !(fn.Name == "arg" || fn.Name == "narg" || fn.Name == "slice" || fn.Name == "embed" || fn.Name == "embed_many" || fn.Name == "optional" || fn.Name == "sort") {
 			v.err = sqlerr.FunctionNotFound("sqlc." + fn.Name)
 			return nil
 		}
 
-		if len(call.Args.Items) != 1 {
+		// sqlc.sort takes a list of columns
+		if fn.Name == "sort" && len(call.Args.Items) == 0 {
 			v.err = &sqlerr.Error{
-				Message:  fmt.Sprintf("expected 1 parameter to sqlc.%s; got %d", fn.Name, len(call.Args.Items)),
+				Message:  "expected at least 1 parameter to sqlc.sort; got 0",
 				Location: call.Pos(),
 			}
 			return nil
 		}
-		switch n := call.Args.Items[0].(type) {
-		case *ast.A_Const:
-		case *ast.ColumnRef:
-		default:
+		if fn.Name != "sort" && len(call.Args.Items) != 1 {
 			v.err = &sqlerr.Error{
-				Message:  fmt.Sprintf("expected parameter to sqlc.%s to be string or reference; got %T", fn.Name, n),
+				Message:  fmt.Sprintf("expected 1 parameter to sqlc.%s; got %d", fn.Name, len(call.Args.Items)),
 				Location: call.Pos(),
 			}
 			return nil
 		}
 
+		// sqlc.optional wraps a predicate, which is validated like the rest
+		// of the query
+		if fn.Name == "optional" {
+			return v
+		}
+
+		for _, arg := range call.Args.Items {
+			switch n := arg.(type) {
+			case *ast.A_Const:
+			case *ast.ColumnRef:
+			default:
+				v.err = &sqlerr.Error{
+					Message:  fmt.Sprintf("expected parameter to sqlc.%s to be string or reference; got %T", fn.Name, n),
+					Location: call.Pos(),
+				}
+				return nil
+			}
+		}
+
 		// If we have sqlc.arg or sqlc.narg, there is no need to resolve the function call.
 		// It won't resolve anyway, sinc it is not a real function.
 		return nil
@@ -0,0 +1,51 @@
+package validate
+
+import (
+	"github.com/ZeyuRemtes/sqlc/internal/sql/ast"
+	"github.com/ZeyuRemtes/sqlc/internal/sql/astutils"
+	"github.com/ZeyuRemtes/sqlc/internal/sql/sqlerr"
+)
+
+// Optional checks that sqlc.optional is only used for predicates joined with
+// AND at the top level of a WHERE clause. Dropping the predicate then only
+// widens the result, which is what callers expect from a missing filter.
+func Optional(n ast.Node) error {
+	allowed := map[ast.Node]bool{}
+	astutils.Walk(astutils.VisitorFunc(func(node ast.Node) {
+		switch n := node.(type) {
+		case *ast.SelectStmt:
+			andTerms(n.WhereClause, allowed)
+		case *ast.UpdateStmt:
+			andTerms(n.WhereClause, allowed)
+		case *ast.DeleteStmt:
+			andTerms(n.WhereClause, allowed)
+		}
+	}), n)
+
+	var err error
+	astutils.Walk(astutils.VisitorFunc(func(node ast.Node) {
+		call, ok := node.(*ast.FuncCall)
+		if !ok || err != nil || allowed[call] {
+			return
+		}
+		if call.Func != nil && call.Func.Schema == "sqlc" && call.Func.Name == "optional" {
+			err = &sqlerr.Error{
+				Message:  "sqlc.optional can only be used for predicates joined with AND in a WHERE clause",
+				Location: call.Pos(),
+			}
+		}
+	}), n)
+	return err
+}
+
+func andTerms(node ast.Node, terms map[ast.Node]bool) {
+	if expr, ok := node.(*ast.BoolExpr); ok && expr.Boolop == ast.BoolExprTypeAnd {
+		for _, arg := range expr.Args.Items {
+			andTerms(arg, terms)
+		}
+		return
+	}
+	if node != nil {
+		terms[node] = true
+	}
+}